@@ -2,7 +2,8 @@ package client
 
 import (
 	"fmt"
-	"github.com/onfleet/gonfleet/service/routePlan"
+	"log/slog"
+	"net/http"
 	"time"
 
 	"github.com/onfleet/gonfleet/netwrk"
@@ -13,12 +14,11 @@ import (
 	"github.com/onfleet/gonfleet/service/organization"
 	"github.com/onfleet/gonfleet/service/providers/manifest"
 	"github.com/onfleet/gonfleet/service/recipient"
+	"github.com/onfleet/gonfleet/service/routePlan"
 	"github.com/onfleet/gonfleet/service/task"
 	"github.com/onfleet/gonfleet/service/team"
 	"github.com/onfleet/gonfleet/service/webhook"
 	"github.com/onfleet/gonfleet/service/worker"
-
-	"golang.org/x/time/rate"
 )
 
 // user overridable defaults
@@ -43,6 +43,20 @@ type API struct {
 	Workers          *worker.Client
 	ManifestProvider *manifest.Client
 	RoutePlans       *routePlan.Client
+
+	rlHttpClient *netwrk.RlHttpClient
+}
+
+// RateLimiter returns the limiter shared by every service client of api.
+func (api *API) RateLimiter() netwrk.RateLimiter {
+	return api.rlHttpClient.RateLimiter
+}
+
+// RateLimitStatus reports how the rate limiter has adapted to the rate limit
+// headers returned by Onfleet. It is the zero value when a RateLimiter that
+// does not report its status was provided through InitParams.
+func (api *API) RateLimitStatus() netwrk.RateLimitStatus {
+	return api.rlHttpClient.RateLimitStatus()
 }
 
 // InitParams accepts user provided overrides to be set on Config
@@ -53,6 +67,25 @@ type InitParams struct {
 	Path              string
 	ApiVersion        string
 	MaxCallsPerSecond int
+	// RateLimiter replaces the adaptive limiter built from MaxCallsPerSecond.
+	// Pass the same limiter to several clients to have them share one budget.
+	RateLimiter netwrk.RateLimiter
+	// HttpClient is used to send requests instead of a default client. Its
+	// Timeout is kept when set, otherwise UserTimeout applies.
+	HttpClient *http.Client
+	// Transport replaces the transport of the default client, or of
+	// HttpClient when both are set.
+	Transport http.RoundTripper
+	// Logger receives a record for every attempt of every call: method,
+	// url, status, duration, attempt, request id and error code. The API key
+	// and phone numbers are redacted. Nil disables logging.
+	Logger *slog.Logger
+	// Middlewares wrap every call made by every service client. The first
+	// middleware is the outermost one.
+	Middlewares []netwrk.Middleware
+	// RetryPolicy used for every call unless overridden per call with
+	// netwrk.WithRetryPolicy. Nil means netwrk.DefaultRetryPolicy.
+	RetryPolicy *netwrk.RetryPolicy
 }
 
 func New(apiKey string, params *InitParams) (*API, error) {
@@ -66,6 +99,12 @@ func New(apiKey string, params *InitParams) (*API, error) {
 	apiVersion := defaultApiVersion
 	timeout := defaultUserTimeout
 	maxCallsPerSecond := defaultMaxCallsPerSecond
+	retryPolicy := netwrk.DefaultRetryPolicy()
+	var rateLimiter netwrk.RateLimiter
+	var httpClient *http.Client
+	var transport http.RoundTripper
+	var middlewares []netwrk.Middleware
+	var logger *slog.Logger
 	if params != nil {
 		if params.BaseUrl != "" {
 			baseUrl = params.BaseUrl
@@ -82,81 +121,112 @@ func New(apiKey string, params *InitParams) (*API, error) {
 		if params.MaxCallsPerSecond > 0 && params.MaxCallsPerSecond <= defaultMaxCallsPerSecond {
 			maxCallsPerSecond = params.MaxCallsPerSecond
 		}
+		if params.RateLimiter != nil {
+			rateLimiter = params.RateLimiter
+		}
+		if params.HttpClient != nil {
+			httpClient = params.HttpClient
+		}
+		if params.Transport != nil {
+			transport = params.Transport
+		}
+		middlewares = params.Middlewares
+		logger = params.Logger
+		if params.RetryPolicy != nil {
+			retryPolicy = params.RetryPolicy
+		}
 	}
 
-	rlHttpClient := netwrk.NewRlHttpClient(
-		rate.NewLimiter(rate.Every(1*time.Second), maxCallsPerSecond),
-		timeout,
-	)
+	if rateLimiter == nil {
+		rateLimiter = netwrk.NewAdaptiveRateLimiter(maxCallsPerSecond)
+	}
+
+	// copy so the caller's client is not modified
+	baseHttpClient := http.Client{}
+	if httpClient != nil {
+		baseHttpClient = *httpClient
+	}
+	if baseHttpClient.Timeout == 0 {
+		baseHttpClient.Timeout = time.Duration(timeout) * time.Millisecond
+	}
+	if transport != nil {
+		baseHttpClient.Transport = transport
+	}
+
+	rlHttpClient := netwrk.WrapHttpClient(&baseHttpClient, rateLimiter)
+	rlHttpClient.RetryPolicy = retryPolicy
+	rlHttpClient.Logger = logger
+	api.rlHttpClient = rlHttpClient
 
+	call := netwrk.Chain(netwrk.Call, middlewares...)
 	fullBaseUrl := baseUrl + path + apiVersion
 
 	api.Administrators = admin.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/admins",
-		netwrk.Call,
+		call,
 	)
 	api.Containers = container.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/containers",
-		netwrk.Call,
+		call,
 	)
 	api.Destinations = destination.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/destinations",
-		netwrk.Call,
+		call,
 	)
 	api.Hubs = hub.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/hubs",
-		netwrk.Call,
+		call,
 	)
 	api.Organizations = organization.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/organization",
 		fullBaseUrl+"/organizations",
-		netwrk.Call,
+		call,
 	)
 	api.Recipients = recipient.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/recipients",
-		netwrk.Call,
+		call,
 	)
 	api.Tasks = task.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/tasks",
-		netwrk.Call,
+		call,
 	)
 	api.Teams = team.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/teams",
-		netwrk.Call,
+		call,
 	)
 	api.Webhooks = webhook.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/webhooks",
-		netwrk.Call,
+		call,
 	)
 	api.Workers = worker.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/workers",
-		netwrk.Call,
+		call,
 	)
 	api.RoutePlans = routePlan.Plug(
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/routePlans",
-		netwrk.Call,
+		call,
 	)
 
 	// Integration Marketplace Providers
@@ -164,7 +234,7 @@ func New(apiKey string, params *InitParams) (*API, error) {
 		apiKey,
 		rlHttpClient,
 		fullBaseUrl+"/integrations/marketplace",
-		netwrk.Call,
+		call,
 	)
 
 	return &api, nil
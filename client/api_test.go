@@ -1,9 +1,16 @@
 package client
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+
+	"github.com/onfleet/gonfleet/netwrk"
 )
 
 func TestNew_ValidAPIKey(t *testing.T) {
@@ -334,10 +341,31 @@ func TestNew_RateLimiterConfiguration(t *testing.T) {
 			
 			// Verify services are created
 			assert.NotNil(t, api.Tasks)
+
+			status := api.RateLimitStatus()
+			assert.Equal(t, float64(tt.rateLimit), status.BaseRate)
+			assert.Equal(t, float64(tt.rateLimit), status.CurrentRate)
+			assert.False(t, status.Throttled)
 		})
 	}
 }
 
+func TestNew_SharedRateLimiter(t *testing.T) {
+	shared := rate.NewLimiter(rate.Limit(5), 5)
+	params := &InitParams{
+		RateLimiter: shared,
+	}
+
+	first, err := New("test_api_key_123", params)
+	assert.NoError(t, err)
+	second, err := New("test_api_key_123", params)
+	assert.NoError(t, err)
+
+	assert.Same(t, shared, first.RateLimiter())
+	assert.Same(t, shared, second.RateLimiter())
+	assert.Equal(t, netwrk.RateLimitStatus{}, first.RateLimitStatus())
+}
+
 func TestNew_TimeoutConfiguration(t *testing.T) {
 	// Test different timeout configurations
 	tests := []struct {
@@ -430,4 +458,107 @@ func TestNew_EmptyStringFields(t *testing.T) {
 	// Should still work with defaults
 	assert.NotNil(t, api.Tasks)
 	assert.NotNil(t, api.Workers)
-}
\ No newline at end of file
+}
+
+type recordingTransport struct {
+	requests []*http.Request
+	next     http.RoundTripper
+}
+
+func (rt *recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, r)
+	return rt.next.RoundTrip(r)
+}
+
+func newTaskServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/tasks/task_123", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"task_123","shortId":"abc"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNew_CustomTransport(t *testing.T) {
+	server := newTaskServer(t)
+	transport := &recordingTransport{next: http.DefaultTransport}
+
+	api, err := New("test_api_key_123", &InitParams{
+		BaseUrl:   server.URL,
+		Transport: transport,
+	})
+	assert.NoError(t, err)
+
+	task, err := api.Tasks.Get(context.Background(), "task_123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "task_123", task.ID)
+	assert.Len(t, transport.requests, 1)
+	assert.Equal(t, time.Duration(defaultUserTimeout)*time.Millisecond, api.rlHttpClient.Client.Timeout)
+}
+
+func TestNew_CustomHttpClient(t *testing.T) {
+	server := newTaskServer(t)
+	transport := &recordingTransport{next: server.Client().Transport}
+	httpClient := &http.Client{
+		Transport: transport,
+		Timeout:   5 * time.Second,
+	}
+
+	api, err := New("test_api_key_123", &InitParams{
+		BaseUrl:    server.URL,
+		HttpClient: httpClient,
+	})
+	assert.NoError(t, err)
+
+	task, err := api.Tasks.Get(context.Background(), "task_123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "task_123", task.ID)
+	assert.Len(t, transport.requests, 1)
+	assert.Equal(t, 5*time.Second, api.rlHttpClient.Client.Timeout)
+	assert.NotNil(t, api.RateLimiter())
+}
+
+func TestNew_HttpClientNotModified(t *testing.T) {
+	httpClient := &http.Client{}
+	transport := &recordingTransport{next: http.DefaultTransport}
+
+	_, err := New("test_api_key_123", &InitParams{
+		HttpClient:  httpClient,
+		Transport:   transport,
+		UserTimeout: 1000,
+	})
+
+	assert.NoError(t, err)
+	assert.Nil(t, httpClient.Transport)
+	assert.Equal(t, time.Duration(0), httpClient.Timeout)
+}
+
+func TestNew_Middlewares(t *testing.T) {
+	var urls []string
+	record := func(next netwrk.Caller) netwrk.Caller {
+		return func(ctx context.Context, apiKey string, rlHttpClient *netwrk.RlHttpClient, method string, baseUrl string, pathSegments []string, queryParams any, body any, v any, additionalHeaders ...[2]string) error {
+			urls = append(urls, method+" "+baseUrl)
+			// short circuit so no request is sent
+			return nil
+		}
+	}
+
+	api, err := New("test_api_key_123", &InitParams{
+		Middlewares: []netwrk.Middleware{record},
+	})
+	assert.NoError(t, err)
+
+	api.Tasks.Get(context.Background(), "task_123")
+	api.Workers.List(context.Background())
+	api.Organizations.Get(context.Background())
+
+	assert.Equal(t, []string{
+		"GET https://onfleet.com/api/v2/tasks",
+		"GET https://onfleet.com/api/v2/workers",
+		"GET https://onfleet.com/api/v2/organization",
+	}, urls)
+}
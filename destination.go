@@ -1,15 +1,36 @@
 package onfleet
 
+import "encoding/json"
+
 type Destination struct {
-	Address          DestinationAddress  `json:"address"`
-	GooglePlaceId    string              `json:"googlePlaceId"`
-	ID               string              `json:"id"`
-	Location         DestinationLocation `json:"location"`
-	Metadata         []Metadata          `json:"metadata"`
-	Notes            string              `json:"notes"`
-	TimeCreated      int64               `json:"timeCreated"`
-	TimeLastModified int64               `json:"timeLastModified"`
-	Warnings         []any               `json:"warnings"`
+	Address          DestinationAddress   `json:"address"`
+	GooglePlaceId    string               `json:"googlePlaceId"`
+	ID               string               `json:"id"`
+	Location         DestinationLocation  `json:"location"`
+	Metadata         []Metadata           `json:"metadata"`
+	Notes            string               `json:"notes"`
+	TimeCreated      int64                `json:"timeCreated"`
+	TimeLastModified int64                `json:"timeLastModified"`
+	Warnings         []DestinationWarning `json:"warnings"`
+}
+
+// DestinationWarning is a problem Onfleet found with a destination, such as
+// an address it could only partially geocode.
+type DestinationWarning struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	// Raw is the warning as sent by Onfleet, including fields not decoded
+	// above. Warnings that are not JSON objects are only kept here.
+	Raw json.RawMessage `json:"-"`
+}
+
+func (w *DestinationWarning) UnmarshalJSON(data []byte) error {
+	type plain DestinationWarning
+	var p plain
+	raw, err := unmarshalKeepRaw(data, &p)
+	*w = DestinationWarning(p)
+	w.Raw = raw
+	return err
 }
 
 type DestinationLocation []float64
@@ -2,10 +2,61 @@ package onfleet
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"time"
 )
 
+// Sentinel errors matched by RequestError and TooManyRequestsError through
+// errors.Is. Use errors.As with a RequestError to read the details.
+var (
+	ErrValidation   = errors.New("onfleet: validation failed")
+	ErrUnauthorized = errors.New("onfleet: unauthorized")
+	ErrForbidden    = errors.New("onfleet: forbidden")
+	ErrNotFound     = errors.New("onfleet: not found")
+	ErrConflict     = errors.New("onfleet: conflict")
+	ErrRateLimited  = errors.New("onfleet: rate limited")
+)
+
+// errorCodeSentinels maps Onfleet error codes to sentinel errors.
+var errorCodeSentinels = map[string]error{
+	"BadRequest":          ErrValidation,
+	"InvalidArgument":     ErrValidation,
+	"InvalidContent":      ErrValidation,
+	"MissingArgument":     ErrValidation,
+	"AuthenticationError": ErrUnauthorized,
+	"InvalidCredentials":  ErrUnauthorized,
+	"Unauthorized":        ErrUnauthorized,
+	"Forbidden":           ErrForbidden,
+	"NotAuthorized":       ErrForbidden,
+	"ResourceNotFound":    ErrNotFound,
+	"NotFound":            ErrNotFound,
+	"Conflict":            ErrConflict,
+	"PreconditionFailed":  ErrRateLimited,
+	"TooManyRequests":     ErrRateLimited,
+}
+
+// statusSentinel maps an HTTP status code to a sentinel error.
+func statusSentinel(statusCode int) error {
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusTooManyRequests, http.StatusPreconditionFailed:
+		return ErrRateLimited
+	}
+	return nil
+}
+
 type RequestErrorMessage struct {
 	Cause any `json:"cause,omitempty"`
 	// Error is an internal error code.
@@ -26,12 +77,32 @@ type RequestError struct {
 	Code string `json:"code,omitempty"`
 	// Message contains futher details about the error.
 	Message RequestErrorMessage `json:"message"`
+	// HTTPStatus is the status code of the response. It is zero when the
+	// error was not parsed from a response.
+	HTTPStatus int `json:"-"`
 }
 
 func (err RequestError) Error() string {
 	return fmt.Sprintf("%s: \n  Cause: %s\n  Message: %s", err.Code, err.Message.Cause, err.Message.Message)
 }
 
+// Is matches the sentinel error for the error code, falling back to the
+// HTTP status when the code is unknown.
+func (err RequestError) Is(target error) bool {
+	if sentinel, ok := errorCodeSentinels[err.Code]; ok {
+		return target == sentinel
+	}
+	sentinel := statusSentinel(err.HTTPStatus)
+	return sentinel != nil && target == sentinel
+}
+
+// isOnfleetError reports whether err was decoded from an Onfleet error body
+// rather than from unrelated JSON.
+func (err RequestError) isOnfleetError() bool {
+	m := err.Message
+	return err.Code != "" || m.Cause != nil || m.Error != 0 || m.Message != "" || m.Request != ""
+}
+
 func ParseError(r io.Reader) error {
 	var reqError RequestError
 	if err := json.NewDecoder(r).Decode(&reqError); err != nil {
@@ -40,9 +111,121 @@ func ParseError(r io.Reader) error {
 	return reqError
 }
 
+// maxErrorBodySize bounds how much of an error response body is read.
+const maxErrorBodySize = 64 << 10
+
+// maxHTTPErrorBodyLength bounds the body kept on an HTTPError.
+const maxHTTPErrorBodyLength = 512
+
+// ParseResponseError parses the error body of a non 2xx response into a
+// RequestError carrying the response status code. Bodies that are empty or
+// not an Onfleet error, such as an HTML page from a load balancer, produce
+// an HTTPError instead.
+func ParseResponseError(response *http.Response) error {
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxErrorBodySize))
+	if err != nil {
+		return fmt.Errorf("reading %d response body: %w", response.StatusCode, err)
+	}
+	var reqError RequestError
+	if json.Unmarshal(body, &reqError) == nil && reqError.isOnfleetError() {
+		reqError.HTTPStatus = response.StatusCode
+		return reqError
+	}
+	if len(body) > maxHTTPErrorBodyLength {
+		body = body[:maxHTTPErrorBodyLength]
+	}
+	return HTTPError{
+		StatusCode:  response.StatusCode,
+		ContentType: response.Header.Get("Content-Type"),
+		Body:        string(body),
+	}
+}
+
+// HTTPError is returned for error responses whose body is not an Onfleet
+// error, for example an empty body or an HTML page from a load balancer.
+type HTTPError struct {
+	StatusCode  int
+	ContentType string
+	// Body is the start of the response body, truncated to 512 bytes.
+	Body string
+}
+
+func (err HTTPError) Error() string {
+	if err.Body == "" {
+		return fmt.Sprintf("%d %s: empty response body", err.StatusCode, http.StatusText(err.StatusCode))
+	}
+	return fmt.Sprintf("%d %s (%s): %s", err.StatusCode, http.StatusText(err.StatusCode), err.ContentType, err.Body)
+}
+
+// Is matches the sentinel error for the HTTP status.
+func (err HTTPError) Is(target error) bool {
+	sentinel := statusSentinel(err.StatusCode)
+	return sentinel != nil && target == sentinel
+}
+
+// ResponseDecodeError is returned when a successful response body is empty
+// or cannot be decoded into the expected type.
+type ResponseDecodeError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	// Err is the decode error, io.EOF for an empty body.
+	Err error
+}
+
+func (err ResponseDecodeError) Error() string {
+	if errors.Is(err.Err, io.EOF) {
+		return fmt.Sprintf("%s %s: %d response has an empty body", err.Method, err.URL, err.StatusCode)
+	}
+	return fmt.Sprintf("%s %s: decoding %d response: %v", err.Method, err.URL, err.StatusCode, err.Err)
+}
+
+func (err ResponseDecodeError) Unwrap() error {
+	return err.Err
+}
+
+// TooManyRequestsError is returned for 429 and 412 responses, which
+// Onfleet sends when the rate limit is exceeded.
 type TooManyRequestsError struct {
+	// RetryAfter is the delay suggested by the Retry-After header, zero when
+	// none was sent.
+	RetryAfter time.Duration
+	// HTTPStatus is the status code of the response. It is zero when the
+	// error was not parsed from a response.
+	HTTPStatus int
+	// Message holds the details of the Onfleet error body, empty when the
+	// body was not an Onfleet error.
+	Message RequestErrorMessage
+}
+
+// ParseTooManyRequestsError parses the error body of a rate limited
+// response into a TooManyRequestsError carrying retryAfter.
+func ParseTooManyRequestsError(response *http.Response, retryAfter time.Duration) error {
+	tooMany := TooManyRequestsError{RetryAfter: retryAfter, HTTPStatus: response.StatusCode}
+	var reqErr RequestError
+	if errors.As(ParseResponseError(response), &reqErr) {
+		tooMany.Message = reqErr.Message
+	}
+	return tooMany
 }
 
 func (err TooManyRequestsError) Error() string {
-	return "too many requests"
+	msg := "too many requests"
+	if err.Message.Message != "" {
+		msg += ": " + err.Message.Message
+	}
+	if err.RetryAfter > 0 {
+		msg += fmt.Sprintf(", retry after %v", err.RetryAfter)
+	}
+	return msg
+}
+
+// Is matches ErrRateLimited and any TooManyRequestsError regardless of its
+// fields.
+func (err TooManyRequestsError) Is(target error) bool {
+	if target == ErrRateLimited {
+		return true
+	}
+	_, ok := target.(TooManyRequestsError)
+	return ok
 }
@@ -1,6 +1,6 @@
 module github.com/onfleet/gonfleet
 
-go 1.20
+go 1.21
 
 require (
 	github.com/cenkalti/backoff/v4 v4.3.0
@@ -10,6 +10,15 @@ type Metadata struct {
 	Visibility []MetadataVisibilityOption `json:"visibility,omitempty"`
 }
 
+// Metadata types, used as Metadata.Type and, for arrays, Metadata.Subtype.
+const (
+	MetadataTypeBoolean = "boolean"
+	MetadataTypeNumber  = "number"
+	MetadataTypeString  = "string"
+	MetadataTypeObject  = "object"
+	MetadataTypeArray   = "array"
+)
+
 type MetadataVisibilityOption string
 
 const (
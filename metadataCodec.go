@@ -0,0 +1,284 @@
+package onfleet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// metadataField is a struct field encoded as a Metadata entry.
+type metadataField struct {
+	index      int
+	name       string
+	visibility []MetadataVisibilityOption
+	omitempty  bool
+}
+
+// metadataFields parses the onfleet tags of the struct type t. Tags have
+// the form `onfleet:"name,visibility=api|dashboard,omitempty"`. Untagged
+// exported fields use the field name and fields tagged "-" are skipped.
+func metadataFields(t reflect.Type) ([]metadataField, error) {
+	var fields []metadataField
+	seen := map[string]string{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("onfleet")
+		if !sf.IsExported() || tag == "-" {
+			continue
+		}
+		options := strings.Split(tag, ",")
+		field := metadataField{index: i, name: options[0]}
+		if field.name == "" {
+			field.name = sf.Name
+		}
+		for _, option := range options[1:] {
+			switch {
+			case option == "omitempty":
+				field.omitempty = true
+			case strings.HasPrefix(option, "visibility="):
+				for _, visibility := range strings.Split(strings.TrimPrefix(option, "visibility="), "|") {
+					switch v := MetadataVisibilityOption(visibility); v {
+					case MetadataVisibilityOptionApi, MetadataVisibilityOptionDashboard, MetadataVisibilityOptionWorker:
+						field.visibility = append(field.visibility, v)
+					default:
+						return nil, fmt.Errorf("onfleet: field %s: unknown metadata visibility %q", sf.Name, visibility)
+					}
+				}
+			default:
+				return nil, fmt.Errorf("onfleet: field %s: unknown onfleet tag option %q", sf.Name, option)
+			}
+		}
+		if other, ok := seen[field.name]; ok {
+			return nil, fmt.Errorf("onfleet: fields %s and %s both use metadata name %q", other, sf.Name, field.name)
+		}
+		seen[field.name] = sf.Name
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// metadataTypeOf returns the metadata type and subtype of values of t. The
+// type is empty for interfaces, whose type depends on their value, and the
+// subtype is empty for arrays of interfaces.
+func metadataTypeOf(t reflect.Type, inArray bool) (string, string, error) {
+	if t == timeType {
+		if inArray {
+			return "", "", errors.New("time.Time is not supported in arrays")
+		}
+		return MetadataTypeNumber, "", nil
+	}
+	if t == rawMessageType || t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+		// encoding/json writes these as a base64 string or as is, not as an
+		// array of numbers
+		return "", "", fmt.Errorf("type %s is not supported", t)
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return metadataTypeOf(t.Elem(), inArray)
+	case reflect.Interface:
+		return "", "", nil
+	case reflect.Bool:
+		return MetadataTypeBoolean, "", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return MetadataTypeNumber, "", nil
+	case reflect.String:
+		return MetadataTypeString, "", nil
+	case reflect.Struct:
+		return MetadataTypeObject, "", nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return "", "", fmt.Errorf("objects must have string keys, got %s", t)
+		}
+		return MetadataTypeObject, "", nil
+	case reflect.Slice, reflect.Array:
+		if inArray {
+			return "", "", errors.New("nested arrays are not supported")
+		}
+		subtype, _, err := metadataTypeOf(t.Elem(), true)
+		return MetadataTypeArray, subtype, err
+	}
+	return "", "", fmt.Errorf("type %s is not supported", t)
+}
+
+// indirect follows pointers and interfaces to a concrete value, returning
+// false when it meets nil.
+func indirect(rv reflect.Value) (reflect.Value, bool) {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return rv, false
+		}
+		rv = rv.Elem()
+	}
+	return rv, true
+}
+
+// metadataValue returns the metadata type, subtype and value of rv, which
+// must not be nil.
+func metadataValue(rv reflect.Value) (string, string, any, error) {
+	typ, subtype, err := metadataTypeOf(rv.Type(), false)
+	if err != nil {
+		return "", "", nil, err
+	}
+	if rv.Type() == timeType {
+		return typ, "", Millis(rv.Interface().(time.Time)), nil
+	}
+	if typ == MetadataTypeArray && subtype == "" {
+		// infer the subtype from the elements
+		for i := 0; i < rv.Len(); i++ {
+			elem, ok := indirect(rv.Index(i))
+			if !ok {
+				return "", "", nil, fmt.Errorf("array element %d is null", i)
+			}
+			elemType, _, err := metadataTypeOf(elem.Type(), true)
+			if err != nil {
+				return "", "", nil, err
+			}
+			if subtype != "" && elemType != subtype {
+				return "", "", nil, fmt.Errorf("array mixes %s and %s elements", subtype, elemType)
+			}
+			subtype = elemType
+		}
+		if subtype == "" {
+			return "", "", nil, fmt.Errorf("cannot infer the subtype of an empty %s", rv.Type())
+		}
+	}
+	value := rv.Interface()
+	if _, err := json.Marshal(value); err != nil {
+		return "", "", nil, err
+	}
+	return typ, subtype, value, nil
+}
+
+// MarshalMetadata encodes the exported fields of the struct v, or a pointer
+// to it, as Metadata entries. Each field is named by its onfleet tag, e.g.
+//
+//	type Order struct {
+//		ID      string   `onfleet:"orderId,visibility=api|dashboard"`
+//		Total   float64  `onfleet:"total"`
+//		Items   []string `onfleet:"items,omitempty"`
+//		Ignored string   `onfleet:"-"`
+//	}
+//
+// Types are inferred: bools are "boolean", numbers and time.Time (as epoch
+// milliseconds) are "number", strings are "string", structs and maps with
+// string keys are "object" and slices are "array" with the subtype of
+// their elements. Nil pointers, interfaces, slices and maps are left out,
+// as are zero values of fields tagged omitempty. Other types, including
+// []byte and json.RawMessage, nested arrays and values that cannot be
+// encoded as JSON are refused, and the entries built are checked with
+// ValidateMetadata.
+func MarshalMetadata(v any) ([]Metadata, error) {
+	rv, ok := indirect(reflect.ValueOf(v))
+	if !ok || rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("onfleet: MarshalMetadata needs a struct, got %T", v)
+	}
+	fields, err := metadataFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	metadata := []Metadata{}
+	for _, field := range fields {
+		fv := rv.Field(field.index)
+		if field.omitempty && fv.IsZero() {
+			continue
+		}
+		fv, ok := indirect(fv)
+		if !ok || (fv.Kind() == reflect.Slice || fv.Kind() == reflect.Map) && fv.IsNil() {
+			continue
+		}
+		typ, subtype, value, err := metadataValue(fv)
+		if err != nil {
+			return nil, fmt.Errorf("onfleet: metadata %q: %w", field.name, err)
+		}
+		metadata = append(metadata, Metadata{
+			Name:       field.name,
+			Type:       typ,
+			Subtype:    subtype,
+			Value:      value,
+			Visibility: field.visibility,
+		})
+	}
+	if err := ValidateMetadata(metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// UnmarshalMetadata decodes metadata into the fields of the struct v
+// points to, matching entries by the names MarshalMetadata uses. Fields
+// without an entry are left unchanged. An entry whose type does not match
+// its field is an error.
+func UnmarshalMetadata(metadata []Metadata, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("onfleet: UnmarshalMetadata needs a non nil pointer to a struct, got %T", v)
+	}
+	rv = rv.Elem()
+	fields, err := metadataFields(rv.Type())
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]Metadata, len(metadata))
+	for _, m := range metadata {
+		byName[m.Name] = m
+	}
+	for _, field := range fields {
+		m, ok := byName[field.name]
+		if !ok {
+			continue
+		}
+		if err := decodeMetadataValue(m, rv.Field(field.index)); err != nil {
+			return fmt.Errorf("onfleet: metadata %q: %w", field.name, err)
+		}
+	}
+	return nil
+}
+
+func decodeMetadataValue(m Metadata, field reflect.Value) error {
+	typ, subtype, err := metadataTypeOf(field.Type(), false)
+	if err != nil {
+		return err
+	}
+	if typ != "" && (m.Type != typ || subtype != "" && m.Subtype != subtype) {
+		return fmt.Errorf("is %s, field of type %s needs %s", metadataTypeName(m.Type, m.Subtype), field.Type(), metadataTypeName(typ, subtype))
+	}
+	raw, err := json.Marshal(m.Value)
+	if err != nil {
+		return err
+	}
+	if field.Type() == timeType || field.Type() == reflect.PointerTo(timeType) {
+		if string(raw) == "null" {
+			field.SetZero()
+			return nil
+		}
+		var ms int64
+		if err := json.Unmarshal(raw, &ms); err != nil {
+			return err
+		}
+		t := FromMillis(ms)
+		if field.Kind() == reflect.Pointer {
+			field.Set(reflect.ValueOf(&t))
+		} else {
+			field.Set(reflect.ValueOf(t))
+		}
+		return nil
+	}
+	return json.Unmarshal(raw, field.Addr().Interface())
+}
+
+func metadataTypeName(typ string, subtype string) string {
+	if subtype != "" {
+		return typ + " of " + subtype
+	}
+	return typ
+}
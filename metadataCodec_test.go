@@ -0,0 +1,144 @@
+package onfleet
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type orderMetadata struct {
+	ID         string            `onfleet:"orderId,visibility=api|dashboard"`
+	Total      float64           `onfleet:"total"`
+	Fragile    bool              `onfleet:"fragile"`
+	Items      []string          `onfleet:"items,omitempty"`
+	Customer   *orderCustomer    `onfleet:"customer"`
+	Labels     map[string]string `onfleet:"labels"`
+	PlacedAt   time.Time         `onfleet:"placedAt"`
+	Count      int
+	Extra      any    `onfleet:"extra"`
+	Ignored    string `onfleet:"-"`
+	unexported string
+}
+
+type orderCustomer struct {
+	Name string `json:"name"`
+}
+
+func TestMarshalMetadata(t *testing.T) {
+	placedAt := time.Date(2022, 1, 1, 2, 0, 0, 0, time.UTC)
+	order := orderMetadata{
+		ID:       "order_1",
+		Total:    12.5,
+		Fragile:  true,
+		Customer: &orderCustomer{Name: "Bob"},
+		PlacedAt: placedAt,
+		Count:    3,
+		Extra:    []any{1, 2.5},
+		Ignored:  "ignored",
+	}
+
+	metadata, err := MarshalMetadata(&order)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Metadata{
+		{Name: "orderId", Type: "string", Value: "order_1", Visibility: []MetadataVisibilityOption{"api", "dashboard"}},
+		{Name: "total", Type: "number", Value: 12.5},
+		{Name: "fragile", Type: "boolean", Value: true},
+		{Name: "customer", Type: "object", Value: orderCustomer{Name: "Bob"}},
+		{Name: "placedAt", Type: "number", Value: int64(1641002400000)},
+		{Name: "Count", Type: "number", Value: 3},
+		{Name: "extra", Type: "array", Subtype: "number", Value: []any{1, 2.5}},
+	}, metadata)
+}
+
+func TestMarshalMetadata_Refused(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+		err  string
+	}{
+		{name: "not a struct", v: "order", err: "needs a struct"},
+		{name: "nested array", v: struct{ A [][]string }{A: [][]string{{"a"}}}, err: "nested arrays"},
+		{name: "mixed array", v: struct{ A []any }{A: []any{"a", 1}}, err: "mixes string and number"},
+		{name: "empty any array", v: struct{ A []any }{A: []any{}}, err: "cannot infer the subtype"},
+		{name: "non string keys", v: struct{ A map[int]string }{A: map[int]string{1: "a"}}, err: "string keys"},
+		{name: "NaN", v: struct{ A float64 }{A: math.NaN()}, err: "NaN"},
+		{name: "bytes", v: struct{ A []byte }{A: []byte("hi")}, err: "[]uint8 is not supported"},
+		{name: "raw message", v: struct{ A json.RawMessage }{A: json.RawMessage(`1`)}, err: "is not supported"},
+		{name: "bytes in any", v: struct{ A any }{A: []byte("hi")}, err: "[]uint8 is not supported"},
+		{name: "channel", v: struct{ A chan int }{A: make(chan int)}, err: "not supported"},
+		{name: "visibility", v: struct {
+			A string `onfleet:"a,visibility=everyone"`
+		}{}, err: "unknown metadata visibility"},
+		{name: "duplicate name", v: struct {
+			A string `onfleet:"a"`
+			B string `onfleet:"a"`
+		}{}, err: "both use metadata name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := MarshalMetadata(tt.v)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestUnmarshalMetadata(t *testing.T) {
+	// metadata as decoded from an Onfleet response
+	var metadata []Metadata
+	err := json.Unmarshal([]byte(`[
+		{"name": "orderId", "type": "string", "value": "order_1"},
+		{"name": "total", "type": "number", "value": 12.5},
+		{"name": "items", "type": "array", "subtype": "string", "value": ["a", "b"]},
+		{"name": "customer", "type": "object", "value": {"name": "Bob"}},
+		{"name": "placedAt", "type": "number", "value": 1641002400000},
+		{"name": "Count", "type": "number", "value": 3},
+		{"name": "extra", "type": "boolean", "value": true},
+		{"name": "unknown", "type": "string", "value": "x"}
+	]`), &metadata)
+	assert.NoError(t, err)
+
+	order := orderMetadata{Fragile: true}
+	err = UnmarshalMetadata(metadata, &order)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "order_1", order.ID)
+	assert.Equal(t, 12.5, order.Total)
+	assert.True(t, order.Fragile)
+	assert.Equal(t, []string{"a", "b"}, order.Items)
+	assert.Equal(t, &orderCustomer{Name: "Bob"}, order.Customer)
+	assert.True(t, order.PlacedAt.Equal(time.Date(2022, 1, 1, 2, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 3, order.Count)
+	assert.Equal(t, true, order.Extra)
+}
+
+func TestUnmarshalMetadata_TypeMismatch(t *testing.T) {
+	var order orderMetadata
+
+	err := UnmarshalMetadata([]Metadata{{Name: "total", Type: "string", Value: "12.5"}}, &order)
+	assert.ErrorContains(t, err, `metadata "total": is string, field of type float64 needs number`)
+
+	err = UnmarshalMetadata([]Metadata{{Name: "items", Type: "array", Subtype: "number", Value: []any{1}}}, &order)
+	assert.ErrorContains(t, err, "needs array of string")
+
+	err = UnmarshalMetadata(nil, order)
+	assert.ErrorContains(t, err, "needs a non nil pointer")
+}
+
+func TestMetadata_RoundTrip(t *testing.T) {
+	order := orderMetadata{ID: "order_1", Items: []string{"a"}, Labels: map[string]string{"k": "v"}, PlacedAt: time.UnixMilli(1641002400000)}
+
+	metadata, err := MarshalMetadata(order)
+	assert.NoError(t, err)
+	raw, _ := json.Marshal(metadata)
+	var decoded []Metadata
+	assert.NoError(t, json.Unmarshal(raw, &decoded))
+
+	var roundTripped orderMetadata
+	assert.NoError(t, UnmarshalMetadata(decoded, &roundTripped))
+	assert.Equal(t, order, roundTripped)
+}
@@ -0,0 +1,208 @@
+package netwrk
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+
+	onfleet "github.com/onfleet/gonfleet"
+)
+
+const redacted = "REDACTED"
+
+// phonePattern matches values that could be phone numbers: an optional
+// leading "+" or "(" followed by digits and common separators.
+// phoneTextPattern finds such values in free text, such as error messages
+// and response bodies, and datePattern spots dates among them.
+var (
+	phonePattern     = regexp.MustCompile(`^[+(]?[0-9][0-9 ().\-]{5,}[0-9]$`)
+	phoneTextPattern = regexp.MustCompile(`[+(]?[0-9][0-9 ().\-]{5,}[0-9]`)
+	datePattern      = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}`)
+	dottedPattern    = regexp.MustCompile(`^([0-9]{2,4}\.){2,}[0-9]{4}$`)
+)
+
+// isPhoneLike reports whether value looks like a phone number of at least
+// seven digits. Bare digit runs are ambiguous with ids and timestamps, so
+// they only count when phoneContext says the value is known to be a phone,
+// e.g. under a phone query key. Otherwise the value must start with "+" or
+// "(", be grouped with spaces, dashes or parentheses, or be grouped with
+// dots ending in four digits, e.g. 555.111.2222, which leaves dates,
+// decimals and IP addresses readable.
+func isPhoneLike(value string, phoneContext bool) bool {
+	if !phonePattern.MatchString(value) || datePattern.MatchString(value) {
+		return false
+	}
+	digits := 0
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	switch {
+	case digits < 7:
+		return false
+	case phoneContext, strings.HasPrefix(value, "+"), strings.HasPrefix(value, "("):
+		return true
+	case strings.ContainsAny(value, " ()-"):
+		return true
+	}
+	return dottedPattern.MatchString(value)
+}
+
+// redactPhones replaces phone numbers in text, as isPhoneLike tells them. A
+// bare digit run is only replaced when "phone" appears shortly before it,
+// e.g. in {"phone":"4155550123"}.
+func redactPhones(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range phoneTextPattern.FindAllStringIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		if start > 0 && isWordByte(text[start-1]) || end < len(text) && isWordByte(text[end]) {
+			continue
+		}
+		before := strings.ToLower(text[max(0, start-16):start])
+		if !isPhoneLike(text[start:end], strings.Contains(before, "phone")) {
+			continue
+		}
+		b.WriteString(text[last:start])
+		b.WriteString(redacted)
+		last = end
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+func isWordByte(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+// redactUrl removes credentials and phone numbers from rawUrl so it can be
+// logged. The path segment after a "phone" segment is always redacted, and
+// query values under a phone key are checked as phones, see isPhoneLike.
+func redactUrl(rawUrl string, apiKey string) string {
+	if apiKey != "" {
+		rawUrl = strings.ReplaceAll(rawUrl, apiKey, redacted)
+	}
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return redacted
+	}
+	if u.User != nil {
+		u.User = url.User(redacted)
+	}
+	if u.Path != "" {
+		segments := strings.Split(u.Path, "/")
+		for i, segment := range segments {
+			afterPhone := i > 0 && strings.EqualFold(segments[i-1], "phone")
+			if afterPhone && segment != "" || isPhoneLike(segment, false) {
+				segments[i] = redacted
+			}
+		}
+		u.Path = strings.Join(segments, "/")
+		u.RawPath = ""
+	}
+	if u.RawQuery != "" {
+		q := u.Query()
+		for key, values := range q {
+			phoneKey := strings.Contains(strings.ToLower(key), "phone")
+			for i, value := range values {
+				parts := strings.Split(value, ",")
+				for j, part := range parts {
+					if isPhoneLike(part, phoneKey) {
+						parts[j] = redacted
+					}
+				}
+				values[i] = strings.Join(parts, ",")
+			}
+			q[key] = values
+		}
+		u.RawQuery = q.Encode()
+	}
+	return u.String()
+}
+
+// logAttempt logs a single attempt of a call made by Call. Successful
+// attempts are logged at debug level, failures that will be retried at warn
+// level and final failures at error level.
+func logAttempt(ctx context.Context, logger *slog.Logger, apiKey string, method string, attempt int, res callResult, err error, retrying bool) {
+	if logger == nil {
+		return
+	}
+	level := slog.LevelDebug
+	msg := "onfleet call succeeded"
+	if err != nil && retrying {
+		level = slog.LevelWarn
+		msg = "onfleet call failed, retrying"
+	} else if err != nil {
+		level = slog.LevelError
+		msg = "onfleet call failed"
+	}
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	requestId := requestIdOf(res, err)
+	var errorCode string
+	var reqErr onfleet.RequestError
+	if errors.As(err, &reqErr) {
+		errorCode = reqErr.Code
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("url", redactUrl(res.url, apiKey)),
+		slog.Int("attempt", attempt),
+		slog.Duration("duration", res.duration),
+	}
+	if res.statusCode != 0 {
+		attrs = append(attrs, slog.Int("status", res.statusCode))
+	}
+	if requestId != "" {
+		attrs = append(attrs, slog.String("request_id", requestId))
+	}
+	if errorCode != "" {
+		attrs = append(attrs, slog.String("error_code", errorCode))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", redactError(err, apiKey)))
+	}
+	logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// redactError returns the message of err with any request url, the API key
+// and phone numbers, e.g. echoed back in an error body, redacted.
+func redactError(err error, apiKey string) string {
+	msg := err.Error()
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		msg = strings.ReplaceAll(msg, urlErr.URL, redactUrl(urlErr.URL, apiKey))
+	}
+	var decodeErr onfleet.ResponseDecodeError
+	if errors.As(err, &decodeErr) {
+		msg = strings.ReplaceAll(msg, decodeErr.URL, redactUrl(decodeErr.URL, apiKey))
+	}
+	if apiKey != "" {
+		msg = strings.ReplaceAll(msg, apiKey, redacted)
+	}
+	return redactPhones(msg)
+}
+
+// requestIdOf returns the Onfleet request id of an attempt, from the
+// X-Request-Id header or else from the error body.
+func requestIdOf(res callResult, err error) string {
+	if res.requestId != "" {
+		return res.requestId
+	}
+	var reqErr onfleet.RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr.Message.Request
+	}
+	var tooMany onfleet.TooManyRequestsError
+	if errors.As(err, &tooMany) {
+		return tooMany.Message.Request
+	}
+	return ""
+}
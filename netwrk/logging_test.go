@@ -0,0 +1,189 @@
+package netwrk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	onfleet "github.com/onfleet/gonfleet"
+)
+
+func TestRedactUrl(t *testing.T) {
+	tests := []struct {
+		name     string
+		rawUrl   string
+		expected string
+	}{
+		{
+			name:     "no sensitive data",
+			rawUrl:   "https://onfleet.com/api/v2/tasks/abcDEF123",
+			expected: "https://onfleet.com/api/v2/tasks/abcDEF123",
+		},
+		{
+			name:     "phone in path",
+			rawUrl:   "https://onfleet.com/api/v2/recipients/phone/+14155550123",
+			expected: "https://onfleet.com/api/v2/recipients/phone/REDACTED",
+		},
+		{
+			name:     "formatted phone in path",
+			rawUrl:   "https://onfleet.com/api/v2/recipients/phone/%28555%29%20111-2222",
+			expected: "https://onfleet.com/api/v2/recipients/phone/REDACTED",
+		},
+		{
+			name:     "any value after phone in path",
+			rawUrl:   "https://onfleet.com/api/v2/recipients/phone/5551112222",
+			expected: "https://onfleet.com/api/v2/recipients/phone/REDACTED",
+		},
+		{
+			name:     "dotted phone in query",
+			rawUrl:   "https://onfleet.com/api/v2/tasks?contact=555.111.2222&from=1640995200",
+			expected: "https://onfleet.com/api/v2/tasks?contact=REDACTED&from=1640995200",
+		},
+		{
+			name:     "phones in query",
+			rawUrl:   "https://onfleet.com/api/v2/workers?phones=%2B14155550123%2C4155550199&states=1",
+			expected: "https://onfleet.com/api/v2/workers?phones=REDACTED%2CREDACTED&states=1",
+		},
+		{
+			name:     "timestamps kept",
+			rawUrl:   "https://onfleet.com/api/v2/tasks/all?from=1455072025000&state=3",
+			expected: "https://onfleet.com/api/v2/tasks/all?from=1455072025000&state=3",
+		},
+		{
+			name:     "e164 phone under any key",
+			rawUrl:   "https://onfleet.com/api/v2/tasks?contact=%2B14155550123",
+			expected: "https://onfleet.com/api/v2/tasks?contact=REDACTED",
+		},
+		{
+			name:     "api key",
+			rawUrl:   "https://secret_key@onfleet.com/api/v2/tasks?key=secret_key",
+			expected: "https://REDACTED@onfleet.com/api/v2/tasks?key=REDACTED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactUrl(tt.rawUrl, "secret_key"); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRedactPhones(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{text: "recipient +14155550123 not found", expected: "recipient REDACTED not found"},
+		{text: "invalid phone (415) 555-0123", expected: "invalid phone REDACTED"},
+		{text: "invalid phone 415-555-0123.", expected: "invalid phone REDACTED."},
+		{text: `{"phone":"4155550123"}`, expected: `{"phone":"REDACTED"}`},
+		{text: "from 1455072025000 to 1455075625000", expected: "from 1455072025000 to 1455075625000"},
+		{text: "dial tcp 127.0.0.1:8080: connection refused", expected: "dial tcp 127.0.0.1:8080: connection refused"},
+		{text: "at 2026-10-16 08:22:55, took 1.2345678s", expected: "at 2026-10-16 08:22:55, took 1.2345678s"},
+		{text: "task abc4155550123 failed", expected: "task abc4155550123 failed"},
+		{text: "invalid phone 555.111.2222", expected: "invalid phone REDACTED"},
+		{text: "recipient 555.111.2222 not found", expected: "recipient REDACTED not found"},
+		{text: "expired at 1640995200", expected: "expired at 1640995200"},
+		{text: "phone 4155550123 is invalid", expected: "phone REDACTED is invalid"},
+		{text: "dial tcp 192.168.100.20:443", expected: "dial tcp 192.168.100.20:443"},
+	}
+
+	for _, tt := range tests {
+		if got := redactPhones(tt.text); got != tt.expected {
+			t.Errorf("redactPhones(%q) = %q, expected %q", tt.text, got, tt.expected)
+		}
+	}
+}
+
+func TestRedactError_EchoedPhone(t *testing.T) {
+	err := onfleet.HTTPError{StatusCode: 502, ContentType: "text/plain", Body: "no route for recipient +14155550123"}
+
+	got := redactError(err, "secret_key")
+
+	if strings.Contains(got, "4155550123") || !strings.Contains(got, "no route for recipient REDACTED") {
+		t.Errorf("Unexpected redacted error %q", got)
+	}
+}
+
+func TestCall_Logging(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set("X-Request-Id", "req_123")
+		if requestCount == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]any{
+			"code": "ResourceNotFound",
+			"message": map[string]any{
+				"message": "recipient not found",
+				"request": "req_123",
+			},
+		})
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	rlHttpClient := newTestRlHttpClient(fastRetryPolicy())
+	rlHttpClient.Logger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	err := Call(context.Background(), "secret_api_key", rlHttpClient, "GET", server.URL, []string{"phone", "+14155550123"}, nil, nil, nil)
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+
+	output := buf.String()
+	if strings.Contains(output, "secret_api_key") {
+		t.Errorf("API key leaked in log output: %s", output)
+	}
+	if strings.Contains(output, "4155550123") {
+		t.Errorf("Phone number leaked in log output: %s", output)
+	}
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Invalid log line %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 log records, got %d", len(records))
+	}
+
+	first, second := records[0], records[1]
+	if first["level"] != "WARN" || first["status"] != float64(503) || first["attempt"] != float64(1) {
+		t.Errorf("Unexpected first record %v", first)
+	}
+	if second["level"] != "ERROR" || second["status"] != float64(404) || second["attempt"] != float64(2) {
+		t.Errorf("Unexpected second record %v", second)
+	}
+	if second["request_id"] != "req_123" || second["error_code"] != "ResourceNotFound" {
+		t.Errorf("Expected request id and error code in %v", second)
+	}
+	if second["method"] != "GET" || !strings.HasSuffix(second["url"].(string), "/phone/REDACTED") {
+		t.Errorf("Unexpected method or url in %v", second)
+	}
+}
+
+func TestCall_NoLoggerDoesNotLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	err := Call(context.Background(), "secret_api_key", newTestRlHttpClient(nil), "GET", server.URL, nil, nil, nil, nil)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
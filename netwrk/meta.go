@@ -0,0 +1,93 @@
+package netwrk
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// metaHeaders are the response headers copied into ResponseMeta.Header.
+var metaHeaders = []string{
+	"Content-Type",
+	"Date",
+	"Retry-After",
+	"X-Request-Id",
+	headerRateLimitLimit,
+	headerRateLimitRemaining,
+	headerRateLimitReset,
+}
+
+// ResponseMeta describes the HTTP response of the last attempt of a call.
+type ResponseMeta struct {
+	// StatusCode is zero when no response was received.
+	StatusCode int
+	// Header holds a selection of response headers: Content-Type, Date,
+	// Retry-After, X-Request-Id and the X-RateLimit headers.
+	Header http.Header
+	// RequestId identifies the request for Onfleet support. It is taken
+	// from the X-Request-Id header or, on errors, from the error body.
+	RequestId string
+	// RateLimitLimit, RateLimitRemaining and RateLimitReset are the rate
+	// limit headers of the response, zero when absent.
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     time.Time
+	// Latency is the round trip time of the last attempt.
+	Latency time.Duration
+	// Retries is the number of attempts made before the last one.
+	Retries int
+}
+
+type responseMetaKey struct{}
+
+// responseMetaCollector serializes the writes of concurrent calls sharing
+// one ResponseMeta.
+type responseMetaCollector struct {
+	mu   sync.Mutex
+	meta *ResponseMeta
+}
+
+func (c *responseMetaCollector) record(meta ResponseMeta) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	*c.meta = meta
+}
+
+// WithResponseMeta returns a copy of ctx that records the response of calls
+// made with it into meta. When several calls share ctx, including the
+// concurrent calls of Tasks.BatchCreateAll and Tasks.ListRange, meta
+// describes the last one to finish. Read meta once the calls have returned.
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, &responseMetaCollector{meta: meta})
+}
+
+func responseMetaFrom(ctx context.Context) *responseMetaCollector {
+	collector, _ := ctx.Value(responseMetaKey{}).(*responseMetaCollector)
+	return collector
+}
+
+// recordResponseMeta fills the ResponseMeta attached to ctx, if any, from the
+// final attempt of a call.
+func recordResponseMeta(ctx context.Context, attempt int, res callResult, requestId string) {
+	collector := responseMetaFrom(ctx)
+	if collector == nil {
+		return
+	}
+	meta := ResponseMeta{
+		StatusCode: res.statusCode,
+		Header:     http.Header{},
+		RequestId:  requestId,
+		Latency:    res.duration,
+		Retries:    attempt - 1,
+	}
+	for _, key := range metaHeaders {
+		if values := res.header.Values(key); len(values) > 0 {
+			meta.Header[key] = append([]string(nil), values...)
+		}
+	}
+	meta.RateLimitLimit, _ = headerInt(res.header, headerRateLimitLimit)
+	meta.RateLimitRemaining, _ = headerInt(res.header, headerRateLimitRemaining)
+	meta.RateLimitReset = parseRateLimitReset(res.header.Get(headerRateLimitReset), time.Now())
+	collector.record(meta)
+}
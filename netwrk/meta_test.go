@@ -0,0 +1,105 @@
+package netwrk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCall_RecordsResponseMeta(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if requestCount == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		w.Header().Set("X-RateLimit-Limit", "20")
+		w.Header().Set("X-RateLimit-Remaining", "19")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.Header().Set("X-Internal", "not copied")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"task_123"}`))
+	}))
+	defer server.Close()
+
+	var meta ResponseMeta
+	ctx := WithResponseMeta(context.Background(), &meta)
+
+	var result map[string]any
+	err := Call(ctx, "test_api_key", newTestRlHttpClient(fastRetryPolicy()), "POST", server.URL, nil, nil, map[string]any{}, &result)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if meta.StatusCode != http.StatusCreated {
+		t.Errorf("Expected status 201, got %d", meta.StatusCode)
+	}
+	if meta.RequestId != "req_123" {
+		t.Errorf("Expected request id req_123, got %q", meta.RequestId)
+	}
+	if meta.Retries != 1 {
+		t.Errorf("Expected 1 retry, got %d", meta.Retries)
+	}
+	if meta.RateLimitLimit != 20 || meta.RateLimitRemaining != 19 || !meta.RateLimitReset.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("Unexpected rate limit info %+v", meta)
+	}
+	if meta.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("Expected Content-Type to be copied, got %v", meta.Header)
+	}
+	if meta.Header.Get("X-Internal") != "" {
+		t.Errorf("Expected unselected headers to be dropped, got %v", meta.Header)
+	}
+	if meta.Latency <= 0 {
+		t.Errorf("Expected positive latency, got %v", meta.Latency)
+	}
+}
+
+func TestCall_RecordsResponseMetaOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]any{
+			"code":    "ResourceNotFound",
+			"message": map[string]any{"request": "req_from_body"},
+		})
+	}))
+	defer server.Close()
+
+	var meta ResponseMeta
+	ctx := WithResponseMeta(context.Background(), &meta)
+
+	err := Call(ctx, "test_api_key", newTestRlHttpClient(fastRetryPolicy()), "GET", server.URL, nil, nil, nil, nil)
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+
+	if meta.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", meta.StatusCode)
+	}
+	if meta.RequestId != "req_from_body" {
+		t.Errorf("Expected request id from error body, got %q", meta.RequestId)
+	}
+	if meta.Retries != 0 {
+		t.Errorf("Expected no retries, got %d", meta.Retries)
+	}
+}
+
+func TestCall_WithoutResponseMeta(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	if responseMetaFrom(context.Background()) != nil {
+		t.Error("Expected no meta on background context")
+	}
+	err := Call(context.Background(), "test_api_key", newTestRlHttpClient(nil), "GET", server.URL, nil, nil, nil, nil)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package netwrk
+
+import (
+	"context"
+	"time"
+)
+
+// Middleware wraps a Caller to add behavior around every call, e.g. audit
+// logging, tracing or request mutation.
+type Middleware func(next Caller) Caller
+
+// Chain wraps call with middlewares. The first middleware is the outermost
+// one, so it sees the call first and the result last.
+func Chain(call Caller, middlewares ...Middleware) Caller {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		if middlewares[i] != nil {
+			call = middlewares[i](call)
+		}
+	}
+	return call
+}
+
+// LoggingMiddleware logs the method, url and outcome of every call with
+// logf, e.g. log.Printf. The API key and phone numbers are redacted.
+func LoggingMiddleware(logf func(format string, args ...any)) Middleware {
+	return func(next Caller) Caller {
+		return func(
+			ctx context.Context,
+			apiKey string,
+			rlHttpClient *RlHttpClient,
+			method string,
+			baseUrl string,
+			pathSegments []string,
+			queryParams any,
+			body any,
+			v any,
+			additionalHeaders ...[2]string,
+		) error {
+			callUrl := redactUrl(callPath(baseUrl, pathSegments), apiKey)
+			start := time.Now()
+			err := next(ctx, apiKey, rlHttpClient, method, baseUrl, pathSegments, queryParams, body, v, additionalHeaders...)
+			if err != nil {
+				logf("onfleet: %s %s failed after %v: %s", method, callUrl, time.Since(start), redactError(err, apiKey))
+			} else {
+				logf("onfleet: %s %s succeeded in %v", method, callUrl, time.Since(start))
+			}
+			return err
+		}
+	}
+}
+
+// TimingMiddleware reports how long every call took, including rate limiter
+// waits and retries, to observe.
+func TimingMiddleware(observe func(method string, url string, elapsed time.Duration, err error)) Middleware {
+	return func(next Caller) Caller {
+		return func(
+			ctx context.Context,
+			apiKey string,
+			rlHttpClient *RlHttpClient,
+			method string,
+			baseUrl string,
+			pathSegments []string,
+			queryParams any,
+			body any,
+			v any,
+			additionalHeaders ...[2]string,
+		) error {
+			start := time.Now()
+			err := next(ctx, apiKey, rlHttpClient, method, baseUrl, pathSegments, queryParams, body, v, additionalHeaders...)
+			observe(method, callPath(baseUrl, pathSegments), time.Since(start), err)
+			return err
+		}
+	}
+}
+
+// HeaderMiddleware adds headers to every call. Headers passed by a service
+// method for a specific call take precedence.
+func HeaderMiddleware(headers ...[2]string) Middleware {
+	return func(next Caller) Caller {
+		return func(
+			ctx context.Context,
+			apiKey string,
+			rlHttpClient *RlHttpClient,
+			method string,
+			baseUrl string,
+			pathSegments []string,
+			queryParams any,
+			body any,
+			v any,
+			additionalHeaders ...[2]string,
+		) error {
+			merged := make([][2]string, 0, len(headers)+len(additionalHeaders))
+			merged = append(merged, headers...)
+			merged = append(merged, additionalHeaders...)
+			return next(ctx, apiKey, rlHttpClient, method, baseUrl, pathSegments, queryParams, body, v, merged...)
+		}
+	}
+}
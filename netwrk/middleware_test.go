@@ -0,0 +1,201 @@
+package netwrk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+type recordedCall struct {
+	method  string
+	url     string
+	headers [][2]string
+}
+
+func recordingCaller(calls *[]recordedCall, err error) Caller {
+	return func(
+		ctx context.Context,
+		apiKey string,
+		rlHttpClient *RlHttpClient,
+		method string,
+		baseUrl string,
+		pathSegments []string,
+		queryParams any,
+		body any,
+		v any,
+		additionalHeaders ...[2]string,
+	) error {
+		*calls = append(*calls, recordedCall{
+			method:  method,
+			url:     callPath(baseUrl, pathSegments),
+			headers: additionalHeaders,
+		})
+		return err
+	}
+}
+
+func tagMiddleware(order *[]string, tag string) Middleware {
+	return func(next Caller) Caller {
+		return func(
+			ctx context.Context,
+			apiKey string,
+			rlHttpClient *RlHttpClient,
+			method string,
+			baseUrl string,
+			pathSegments []string,
+			queryParams any,
+			body any,
+			v any,
+			additionalHeaders ...[2]string,
+		) error {
+			*order = append(*order, tag+":before")
+			err := next(ctx, apiKey, rlHttpClient, method, baseUrl, pathSegments, queryParams, body, v, additionalHeaders...)
+			*order = append(*order, tag+":after")
+			return err
+		}
+	}
+}
+
+func TestChain_Order(t *testing.T) {
+	var calls []recordedCall
+	var order []string
+
+	call := Chain(
+		recordingCaller(&calls, nil),
+		tagMiddleware(&order, "outer"),
+		nil,
+		tagMiddleware(&order, "inner"),
+	)
+	err := call(context.Background(), "test_api_key", nil, "GET", "https://api.example.com/tasks", []string{"task_123"}, nil, nil, nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"outer:before", "inner:before", "inner:after", "outer:after"}
+	if fmt.Sprint(order) != fmt.Sprint(expected) {
+		t.Errorf("Expected order %v, got %v", expected, order)
+	}
+	if len(calls) != 1 || calls[0].url != "https://api.example.com/tasks/task_123" {
+		t.Errorf("Unexpected calls %+v", calls)
+	}
+}
+
+func TestChain_NoMiddlewares(t *testing.T) {
+	var calls []recordedCall
+	call := Chain(recordingCaller(&calls, nil))
+
+	call(context.Background(), "test_api_key", nil, "GET", "https://api.example.com/tasks", nil, nil, nil, nil)
+
+	if len(calls) != 1 {
+		t.Errorf("Expected 1 call, got %d", len(calls))
+	}
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	var calls []recordedCall
+	var lines []string
+	logf := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	call := Chain(recordingCaller(&calls, nil), LoggingMiddleware(logf))
+	call(context.Background(), "secret_api_key", nil, "GET", "https://api.example.com/tasks", []string{"task_123"}, nil, nil, nil)
+
+	failing := Chain(recordingCaller(&calls, errors.New("boom")), LoggingMiddleware(logf))
+	failing(context.Background(), "secret_api_key", nil, "DELETE", "https://api.example.com/tasks", []string{"task_456"}, nil, nil, nil)
+
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 log lines, got %d", len(lines))
+	}
+	if !strings.Contains(lines[0], "GET https://api.example.com/tasks/task_123 succeeded") {
+		t.Errorf("Unexpected log line %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "DELETE https://api.example.com/tasks/task_456 failed") || !strings.Contains(lines[1], "boom") {
+		t.Errorf("Unexpected log line %q", lines[1])
+	}
+	for _, line := range lines {
+		if strings.Contains(line, "secret_api_key") {
+			t.Errorf("API key leaked in log line %q", line)
+		}
+	}
+}
+
+func TestLoggingMiddleware_RedactsError(t *testing.T) {
+	var lines []string
+	logf := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	transportErr := &url.Error{
+		Op:  "Get",
+		URL: "http://127.0.0.1:1/recipients/phone/+14155550123",
+		Err: errors.New("dial tcp 127.0.0.1:1: connect: connection refused"),
+	}
+	call := Chain(recordingCaller(&[]recordedCall{}, transportErr), LoggingMiddleware(logf))
+	call(context.Background(), "secret_api_key", nil, "GET", "http://127.0.0.1:1/recipients", []string{"phone", "+14155550123"}, nil, nil, nil)
+
+	if len(lines) != 1 || strings.Contains(lines[0], "4155550123") {
+		t.Errorf("Phone number leaked in log lines %q", lines)
+	}
+	if !strings.Contains(lines[0], "connection refused") {
+		t.Errorf("Expected error in log line %q", lines[0])
+	}
+}
+
+func TestTimingMiddleware(t *testing.T) {
+	var calls []recordedCall
+	var observedUrl string
+	var observedElapsed time.Duration
+	var observedErr error
+	callErr := errors.New("boom")
+
+	slow := func(next Caller) Caller {
+		return func(ctx context.Context, apiKey string, rlHttpClient *RlHttpClient, method string, baseUrl string, pathSegments []string, queryParams any, body any, v any, additionalHeaders ...[2]string) error {
+			time.Sleep(10 * time.Millisecond)
+			return next(ctx, apiKey, rlHttpClient, method, baseUrl, pathSegments, queryParams, body, v, additionalHeaders...)
+		}
+	}
+	call := Chain(
+		recordingCaller(&calls, callErr),
+		TimingMiddleware(func(method string, url string, elapsed time.Duration, err error) {
+			observedUrl = url
+			observedElapsed = elapsed
+			observedErr = err
+		}),
+		slow,
+	)
+	call(context.Background(), "test_api_key", nil, "GET", "https://api.example.com/workers", nil, nil, nil, nil)
+
+	if observedUrl != "https://api.example.com/workers" {
+		t.Errorf("Unexpected url %q", observedUrl)
+	}
+	if observedElapsed < 10*time.Millisecond {
+		t.Errorf("Expected elapsed of at least 10ms, got %v", observedElapsed)
+	}
+	if observedErr != callErr {
+		t.Errorf("Expected call error to be observed, got %v", observedErr)
+	}
+}
+
+func TestHeaderMiddleware(t *testing.T) {
+	var calls []recordedCall
+
+	call := Chain(
+		recordingCaller(&calls, nil),
+		HeaderMiddleware([2]string{"X-Trace-Id", "trace_123"}, [2]string{"X-Team", "default"}),
+	)
+	call(context.Background(), "test_api_key", nil, "GET", "https://api.example.com/tasks", nil, nil, nil, nil, [2]string{"X-Team", "override"})
+
+	expected := [][2]string{
+		{"X-Trace-Id", "trace_123"},
+		{"X-Team", "default"},
+		{"X-Team", "override"},
+	}
+	if fmt.Sprint(calls[0].headers) != fmt.Sprint(expected) {
+		t.Errorf("Expected headers %v, got %v", expected, calls[0].headers)
+	}
+}
@@ -4,9 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"github.com/cenkalti/backoff/v4"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"time"
@@ -17,20 +16,56 @@ import (
 	"github.com/onfleet/gonfleet/version"
 )
 
+// RateLimiter throttles outgoing calls. Wait blocks until a call may proceed
+// or ctx is done. *rate.Limiter satisfies it, which lets several clients
+// share one budget per API key.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// NewRateLimiter returns a token bucket that allows callsPerSecond sustained
+// calls per second with bursts of up to callsPerSecond calls.
+func NewRateLimiter(callsPerSecond int) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(callsPerSecond), callsPerSecond)
+}
+
 type RlHttpClient struct {
 	Client      *http.Client
-	RateLimiter *rate.Limiter
+	RateLimiter RateLimiter
+	// RetryPolicy used by Call. Nil means DefaultRetryPolicy.
+	RetryPolicy *RetryPolicy
+	// Logger receives one record per attempt made by Call. Nil disables
+	// logging. The API key and phone numbers are redacted.
+	Logger *slog.Logger
 }
 
-func NewRlHttpClient(rl *rate.Limiter, timeout int64) *RlHttpClient {
-	return &RlHttpClient{
-		Client: &http.Client{
+func NewRlHttpClient(rl RateLimiter, timeout int64) *RlHttpClient {
+	return WrapHttpClient(
+		&http.Client{
 			Timeout: time.Duration(timeout) * time.Millisecond,
 		},
+		rl,
+	)
+}
+
+// WrapHttpClient rate limits calls made through an existing http.Client,
+// keeping its transport, proxy, TLS and timeout settings.
+func WrapHttpClient(httpClient *http.Client, rl RateLimiter) *RlHttpClient {
+	return &RlHttpClient{
+		Client:      httpClient,
 		RateLimiter: rl,
 	}
 }
 
+// RateLimitStatus reports the state of the rate limiter when it exposes one,
+// as AdaptiveRateLimiter does. Otherwise the zero value is returned.
+func (c *RlHttpClient) RateLimitStatus() RateLimitStatus {
+	if statuser, ok := c.RateLimiter.(interface{ Status() RateLimitStatus }); ok {
+		return statuser.Status()
+	}
+	return RateLimitStatus{}
+}
+
 // urlAttachPath appends path segments onto provided baseUrl.
 func urlAttachPath(baseUrl string, pathSegments ...string) string {
 	newUrl, err := url.JoinPath(baseUrl, pathSegments...)
@@ -40,36 +75,40 @@ func urlAttachPath(baseUrl string, pathSegments ...string) string {
 	return newUrl
 }
 
-// stomp converts a struct to a map[string]any
-func stomp(v any) (map[string]any, error) {
-	m := map[string]any{}
-	b, err := json.Marshal(v)
-	if err != nil {
-		return m, err
+// callPath returns the url a call is made to, without query parameters.
+func callPath(baseUrl string, pathSegments []string) string {
+	if pathSegments == nil {
+		return baseUrl
 	}
-	err = json.Unmarshal(b, &m)
-	return m, err
+	return urlAttachPath(baseUrl, pathSegments...)
 }
 
-// urlAttachQuery sets query parameters on the provided baseUrl.
-func urlAttachQuery(baseUrl string, v any) string {
+// urlAttachQuery sets query parameters on the provided baseUrl. See
+// encodeQuery for how v is encoded.
+func urlAttachQuery(baseUrl string, v any) (string, error) {
 	URL, err := url.Parse(baseUrl)
 	if err != nil {
-		return baseUrl
+		return baseUrl, err
 	}
-	q := URL.Query()
-	params, err := stomp(v)
+	params, err := encodeQuery(v)
 	if err != nil {
-		return baseUrl
+		return baseUrl, err
+	}
+	if len(params) == 0 {
+		return baseUrl, nil
 	}
-	for k, v := range params {
-		q.Set(k, fmt.Sprintf("%v", v))
+	q := URL.Query()
+	for k, values := range params {
+		q[k] = values
 	}
 	URL.RawQuery = q.Encode()
-	return URL.String()
+	return URL.String(), nil
 }
 
+// Caller performs a single Onfleet API call. Cancelling ctx aborts the rate
+// limiter wait, any pending retry backoff and the in-flight HTTP request.
 type Caller func(
+	ctx context.Context,
 	apiKey string,
 	rlHttpClient *RlHttpClient,
 	method string,
@@ -81,7 +120,11 @@ type Caller func(
 	additionalHeaders ...[2]string,
 ) error
 
+// Call performs an Onfleet API call, retrying failed attempts according to
+// the RetryPolicy attached to ctx, the one configured on rlHttpClient, or
+// DefaultRetryPolicy, in that order.
 func Call(
+	ctx context.Context,
 	apiKey string,
 	rlHttpClient *RlHttpClient,
 	method string,
@@ -92,62 +135,113 @@ func Call(
 	v any,
 	additionalHeaders ...[2]string,
 ) error {
-	exponentialBackOff := backoff.NewExponentialBackOff()
-	exponentialBackOff.MaxElapsedTime = 15 * time.Second
-	ctx := context.Background()
-	b := backoff.WithContext(exponentialBackOff, ctx)
-	return backoff.Retry(func() error {
-		err := callInternal(ctx, apiKey, rlHttpClient, method, baseUrl, pathSegments, queryParams, body, v, additionalHeaders)
-		if errors.Is(err, onfleet.TooManyRequestsError{}) {
+	policy := retryPolicyFor(ctx, rlHttpClient)
+	var logger *slog.Logger
+	if rlHttpClient != nil {
+		logger = rlHttpClient.Logger
+	}
+	exponentialBackOff := policy.newBackOff()
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		res, err := callInternal(ctx, apiKey, rlHttpClient, method, baseUrl, pathSegments, queryParams, body, v, additionalHeaders)
+		if err == nil {
+			logAttempt(ctx, logger, apiKey, method, attempt, res, nil, false)
+			recordResponseMeta(ctx, attempt, res, requestIdOf(res, nil))
+			return nil
+		}
+		if ctx.Err() != nil {
+			logAttempt(ctx, logger, apiKey, method, attempt, res, ctx.Err(), false)
+			recordResponseMeta(ctx, attempt, res, requestIdOf(res, err))
+			return ctx.Err()
+		}
+		wait := exponentialBackOff.NextBackOff()
+		if res.retryAfter > 0 {
+			wait = res.retryAfter
+		}
+		retrying := policy.shouldRetry(method, res, err) &&
+			(policy.MaxAttempts <= 0 || attempt < policy.MaxAttempts) &&
+			(policy.MaxElapsedTime <= 0 || time.Since(start)+wait <= policy.MaxElapsedTime)
+		logAttempt(ctx, logger, apiKey, method, attempt, res, err, retrying)
+		if !retrying {
+			recordResponseMeta(ctx, attempt, res, requestIdOf(res, err))
 			return err
 		}
-		if err != nil {
-			return backoff.Permanent(err)
+		if err := sleep(ctx, wait); err != nil {
+			recordResponseMeta(ctx, attempt, res, requestIdOf(res, err))
+			return err
 		}
+	}
+}
+
+// sleep waits for d between attempts, returning early with the error of ctx
+// when it is done. Tests replace it to skip real waits.
+var sleep = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		return ctx.Err()
+	case <-timer.C:
 		return nil
-	}, b)
+	}
+}
+
+// callResult describes a single attempt. Response fields are empty when no
+// response was received.
+type callResult struct {
+	url        string
+	statusCode int
+	retryAfter time.Duration
+	header     http.Header
+	requestId  string
+	duration   time.Duration
 }
 
-func callInternal(ctx context.Context, apiKey string, rlHttpClient *RlHttpClient, method string, baseUrl string, pathSegments []string, queryParams any, body any, v any, additionalHeaders [][2]string) error {
+func callInternal(ctx context.Context, apiKey string, rlHttpClient *RlHttpClient, method string, baseUrl string, pathSegments []string, queryParams any, body any, v any, additionalHeaders [][2]string) (callResult, error) {
+	var res callResult
 	var request *http.Request
 	var err error
 
-	callUrl := baseUrl
-	if pathSegments != nil {
-		callUrl = urlAttachPath(callUrl, pathSegments...)
-	}
+	callUrl := callPath(baseUrl, pathSegments)
+	res.url = callUrl
 	if queryParams != nil {
-		callUrl = urlAttachQuery(callUrl, queryParams)
+		callUrl, err = urlAttachQuery(callUrl, queryParams)
+		if err != nil {
+			return res, err
+		}
 	}
+	res.url = callUrl
 
 	switch method {
 	case "GET", "DELETE":
-		request, err = http.NewRequest(
+		request, err = http.NewRequestWithContext(
+			ctx,
 			method,
 			callUrl,
 			nil,
 		)
 		if err != nil {
-			return err
+			return res, err
 		}
 		request.Header.Set("Accept", "application/json")
 	case "POST", "PUT":
 		bodyMarshal, errMarshal := json.Marshal(body)
 		if errMarshal != nil {
-			return errMarshal
+			return res, errMarshal
 		}
 		buffer := bytes.NewBuffer(bodyMarshal)
-		request, err = http.NewRequest(
+		request, err = http.NewRequestWithContext(
+			ctx,
 			method,
 			callUrl,
 			buffer,
 		)
 		if err != nil {
-			return err
+			return res, err
 		}
 		request.Header.Set("Content-Type", "application/json")
 	default:
-		return fmt.Errorf("unsupported method: %s", method)
+		return res, fmt.Errorf("unsupported method: %s", method)
 	}
 
 	for _, h := range additionalHeaders {
@@ -160,24 +254,40 @@ func callInternal(ctx context.Context, apiKey string, rlHttpClient *RlHttpClient
 
 	err = rlHttpClient.RateLimiter.Wait(ctx)
 	if err != nil {
-		return err
+		return res, err
 	}
+	start := time.Now()
 	response, err := rlHttpClient.Client.Do(request)
+	res.duration = time.Since(start)
 	if err != nil {
-		return err
+		return res, err
 	}
 	defer response.Body.Close()
-	if response.StatusCode == http.StatusTooManyRequests || response.StatusCode == http.StatusPreconditionFailed {
-		return onfleet.TooManyRequestsError{}
+	res.statusCode = response.StatusCode
+	res.header = response.Header
+	res.requestId = response.Header.Get("X-Request-Id")
+	if observer, ok := rlHttpClient.RateLimiter.(RateLimitObserver); ok {
+		observer.ObserveRateLimit(response.StatusCode, response.Header)
 	}
 	if response.StatusCode < 200 || response.StatusCode > 299 {
-		return onfleet.ParseError(response.Body)
+		res.retryAfter = parseRetryAfter(response.Header.Get("Retry-After"), time.Now())
 	}
-	if v == nil {
-		return nil
+	if isRateLimitStatus(response.StatusCode) {
+		return res, onfleet.ParseTooManyRequestsError(response, res.retryAfter)
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return res, onfleet.ParseResponseError(response)
+	}
+	if v == nil || response.StatusCode == http.StatusNoContent {
+		return res, nil
 	}
 	if err = json.NewDecoder(response.Body).Decode(v); err != nil {
-		return err
+		return res, onfleet.ResponseDecodeError{
+			Method:     method,
+			URL:        callPath(baseUrl, pathSegments),
+			StatusCode: response.StatusCode,
+			Err:        err,
+		}
 	}
-	return nil
+	return res, nil
 }
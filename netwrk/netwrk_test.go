@@ -3,7 +3,9 @@ package netwrk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -53,6 +55,31 @@ func TestNewRlHttpClient(t *testing.T) {
 	}
 }
 
+func TestNewRateLimiter(t *testing.T) {
+	rl := NewRateLimiter(18)
+
+	if rl.Limit() != rate.Limit(18) {
+		t.Errorf("Expected limit of 18 calls per second, got %v", rl.Limit())
+	}
+	if rl.Burst() != 18 {
+		t.Errorf("Expected burst of 18, got %d", rl.Burst())
+	}
+
+	// After draining the burst the next token must arrive in ~1/18s, not 1s
+	now := time.Now()
+	for i := 0; i < 18; i++ {
+		if !rl.AllowN(now, 1) {
+			t.Fatalf("Expected burst token %d to be available", i)
+		}
+	}
+	if rl.AllowN(now, 1) {
+		t.Error("Expected burst to be exhausted")
+	}
+	if !rl.AllowN(now.Add(time.Second/18+time.Millisecond), 1) {
+		t.Error("Expected a token to refill within 1/18s")
+	}
+}
+
 func TestUrlAttachPath(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -102,75 +129,6 @@ func TestUrlAttachPath(t *testing.T) {
 	}
 }
 
-func TestStomp(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    interface{}
-		expected map[string]any
-		hasError bool
-	}{
-		{
-			name: "simple struct",
-			input: struct {
-				Name string `json:"name"`
-				Age  int    `json:"age"`
-			}{
-				Name: "John",
-				Age:  30,
-			},
-			expected: map[string]any{
-				"name": "John",
-				"age":  float64(30), // JSON unmarshals numbers as float64
-			},
-			hasError: false,
-		},
-		{
-			name:     "nil input",
-			input:    nil,
-			expected: map[string]any{},
-			hasError: false,
-		},
-		{
-			name: "nested struct",
-			input: struct {
-				User struct {
-					Name string `json:"name"`
-				} `json:"user"`
-				Count int `json:"count"`
-			}{
-				User: struct {
-					Name string `json:"name"`
-				}{Name: "Alice"},
-				Count: 5,
-			},
-			expected: map[string]any{
-				"user": map[string]any{
-					"name": "Alice",
-				},
-				"count": float64(5),
-			},
-			hasError: false,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result, err := stomp(tt.input)
-
-			if tt.hasError && err == nil {
-				t.Error("Expected error but got none")
-			}
-			if !tt.hasError && err != nil {
-				t.Errorf("Unexpected error: %v", err)
-			}
-
-			if !equalMaps(result, tt.expected) {
-				t.Errorf("Expected %+v, got %+v", tt.expected, result)
-			}
-		})
-	}
-}
-
 func TestUrlAttachQuery(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -216,7 +174,10 @@ func TestUrlAttachQuery(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := urlAttachQuery(tt.baseUrl, tt.params)
+			result, err := urlAttachQuery(tt.baseUrl, tt.params)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
 			
 			// For URL query parameters, order might vary, so we need to check components
 			if tt.params == nil || (fmt.Sprintf("%T", tt.params) == "struct {}" && 
@@ -289,7 +250,7 @@ func TestCallInternal_GET(t *testing.T) {
 
 	// Test successful GET request
 	var result map[string]any
-	err := callInternal(
+	_, err := callInternal(
 		context.Background(),
 		"test_api_key",
 		rlHttpClient,
@@ -361,7 +322,7 @@ func TestCallInternal_POST(t *testing.T) {
 	}
 
 	var result map[string]any
-	err := callInternal(
+	_, err := callInternal(
 		context.Background(),
 		"test_api_key",
 		rlHttpClient,
@@ -443,7 +404,7 @@ func TestCallInternal_ErrorResponses(t *testing.T) {
 			rlHttpClient := NewRlHttpClient(rl, 5000)
 
 			var result map[string]any
-			err := callInternal(
+			_, err := callInternal(
 				context.Background(),
 				"test_api_key",
 				rlHttpClient,
@@ -474,6 +435,187 @@ func TestCallInternal_ErrorResponses(t *testing.T) {
 	}
 }
 
+func TestCallInternal_TypedErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		code       string
+		sentinel   error
+	}{
+		{name: "400 invalid argument", statusCode: http.StatusBadRequest, code: "InvalidArgument", sentinel: onfleet.ErrValidation},
+		{name: "401 unknown code", statusCode: http.StatusUnauthorized, code: "", sentinel: onfleet.ErrUnauthorized},
+		{name: "403 forbidden", statusCode: http.StatusForbidden, code: "Forbidden", sentinel: onfleet.ErrForbidden},
+		{name: "404 resource not found", statusCode: http.StatusNotFound, code: "ResourceNotFound", sentinel: onfleet.ErrNotFound},
+		{name: "409 conflict", statusCode: http.StatusConflict, code: "", sentinel: onfleet.ErrConflict},
+		{name: "code wins over status", statusCode: http.StatusBadRequest, code: "ResourceNotFound", sentinel: onfleet.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				json.NewEncoder(w).Encode(map[string]any{
+					"code": tt.code,
+					"message": map[string]any{
+						"error":         1000,
+						"message":       "something went wrong",
+						"request":       "req_123",
+						"remoteAddress": "10.0.0.1",
+					},
+				})
+			}))
+			defer server.Close()
+
+			rl := rate.NewLimiter(rate.Every(1*time.Millisecond), 100)
+			_, err := callInternal(context.Background(), "test_api_key", NewRlHttpClient(rl, 5000), "GET", server.URL, nil, nil, nil, nil, nil)
+
+			if !errors.Is(err, tt.sentinel) {
+				t.Errorf("Expected errors.Is(err, %v), got %v", tt.sentinel, err)
+			}
+			var reqErr onfleet.RequestError
+			if !errors.As(err, &reqErr) {
+				t.Fatalf("Expected RequestError, got %T", err)
+			}
+			if reqErr.HTTPStatus != tt.statusCode {
+				t.Errorf("Expected HTTPStatus %d, got %d", tt.statusCode, reqErr.HTTPStatus)
+			}
+			if reqErr.Message.Request != "req_123" || reqErr.Message.RemoteAddress != "10.0.0.1" {
+				t.Errorf("Expected raw message to be kept, got %+v", reqErr.Message)
+			}
+		})
+	}
+}
+
+func TestCallInternal_TooManyRequestsRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"code":"TooManyRequests","message":{"message":"Slow down","request":"req_123","remoteAddress":"203.0.113.7"}}`))
+	}))
+	defer server.Close()
+
+	rl := rate.NewLimiter(rate.Every(1*time.Millisecond), 100)
+	_, err := callInternal(context.Background(), "test_api_key", NewRlHttpClient(rl, 5000), "GET", server.URL, nil, nil, nil, nil, nil)
+
+	var tooMany onfleet.TooManyRequestsError
+	if !errors.As(err, &tooMany) {
+		t.Fatalf("Expected TooManyRequestsError, got %T", err)
+	}
+	if tooMany.RetryAfter != 7*time.Second {
+		t.Errorf("Expected RetryAfter of 7s, got %v", tooMany.RetryAfter)
+	}
+	if tooMany.HTTPStatus != http.StatusTooManyRequests {
+		t.Errorf("Expected HTTPStatus 429, got %d", tooMany.HTTPStatus)
+	}
+	if tooMany.Message.Request != "req_123" || tooMany.Message.RemoteAddress != "203.0.113.7" {
+		t.Errorf("Expected the error body to be kept, got %+v", tooMany.Message)
+	}
+	if err.Error() != "too many requests: Slow down, retry after 7s" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+	if !errors.Is(err, onfleet.ErrRateLimited) || !errors.Is(err, onfleet.TooManyRequestsError{}) {
+		t.Errorf("Expected err to match ErrRateLimited and TooManyRequestsError{}")
+	}
+}
+
+func TestCallInternal_NonJSONErrorBody(t *testing.T) {
+	longBody := "<html><body>" + strings.Repeat("x", 1000) + "</body></html>"
+	tests := []struct {
+		name        string
+		statusCode  int
+		contentType string
+		body        string
+		sentinel    error
+	}{
+		{name: "html 502", statusCode: http.StatusBadGateway, contentType: "text/html", body: longBody},
+		{name: "empty 404", statusCode: http.StatusNotFound, body: "", sentinel: onfleet.ErrNotFound},
+		{name: "unrelated json", statusCode: http.StatusInternalServerError, contentType: "application/json", body: `{"status":"down"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+				}
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			rl := rate.NewLimiter(rate.Every(1*time.Millisecond), 100)
+			_, err := callInternal(context.Background(), "test_api_key", NewRlHttpClient(rl, 5000), "GET", server.URL, nil, nil, nil, nil, nil)
+
+			var httpErr onfleet.HTTPError
+			if !errors.As(err, &httpErr) {
+				t.Fatalf("Expected HTTPError, got %T: %v", err, err)
+			}
+			if httpErr.StatusCode != tt.statusCode {
+				t.Errorf("Expected status %d, got %d", tt.statusCode, httpErr.StatusCode)
+			}
+			if tt.contentType != "" && httpErr.ContentType != tt.contentType {
+				t.Errorf("Expected content type %q, got %q", tt.contentType, httpErr.ContentType)
+			}
+			if len(httpErr.Body) > 512 || !strings.HasPrefix(tt.body, httpErr.Body) {
+				t.Errorf("Expected truncated body prefix, got %q", httpErr.Body)
+			}
+			if tt.sentinel != nil && !errors.Is(err, tt.sentinel) {
+				t.Errorf("Expected errors.Is(err, %v)", tt.sentinel)
+			}
+		})
+	}
+}
+
+func TestCallInternal_SuccessBodyDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		expectErr  bool
+		expectEOF  bool
+	}{
+		{name: "empty body", statusCode: http.StatusOK, body: "", expectErr: true, expectEOF: true},
+		{name: "invalid json", statusCode: http.StatusOK, body: "<html>", expectErr: true},
+		{name: "wrong shape", statusCode: http.StatusOK, body: `[1,2,3]`, expectErr: true},
+		{name: "no content", statusCode: http.StatusNoContent, body: "", expectErr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			rl := rate.NewLimiter(rate.Every(1*time.Millisecond), 100)
+			var result map[string]any
+			_, err := callInternal(context.Background(), "test_api_key", NewRlHttpClient(rl, 5000), "GET", server.URL, []string{"tasks", "task_123"}, nil, nil, &result, nil)
+
+			if !tt.expectErr {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			var decodeErr onfleet.ResponseDecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("Expected ResponseDecodeError, got %T: %v", err, err)
+			}
+			if !strings.Contains(err.Error(), "GET "+server.URL+"/tasks/task_123") {
+				t.Errorf("Expected endpoint in error message, got %q", err.Error())
+			}
+			if decodeErr.StatusCode != tt.statusCode {
+				t.Errorf("Expected status %d, got %d", tt.statusCode, decodeErr.StatusCode)
+			}
+			if tt.expectEOF != errors.Is(err, io.EOF) {
+				t.Errorf("Unexpected io.EOF match for %v", err)
+			}
+		})
+	}
+}
+
 func TestCallInternal_AdditionalHeaders(t *testing.T) {
 	// Create test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -494,7 +636,7 @@ func TestCallInternal_AdditionalHeaders(t *testing.T) {
 	rl := rate.NewLimiter(rate.Every(1*time.Second), 10)
 	rlHttpClient := NewRlHttpClient(rl, 5000)
 
-	err := callInternal(
+	_, err := callInternal(
 		context.Background(),
 		"test_api_key",
 		rlHttpClient,
@@ -519,7 +661,7 @@ func TestCallInternal_UnsupportedMethod(t *testing.T) {
 	rl := rate.NewLimiter(rate.Every(1*time.Second), 10)
 	rlHttpClient := NewRlHttpClient(rl, 5000)
 
-	err := callInternal(
+	_, err := callInternal(
 		context.Background(),
 		"test_api_key",
 		rlHttpClient,
@@ -568,6 +710,7 @@ func TestCall_WithRetry(t *testing.T) {
 
 	var result map[string]any
 	err := Call(
+		context.Background(),
 		"test_api_key",
 		rlHttpClient,
 		"GET",
@@ -607,6 +750,7 @@ func TestCall_PermanentError(t *testing.T) {
 	rlHttpClient := NewRlHttpClient(rl, 5000)
 
 	err := Call(
+		context.Background(),
 		"test_api_key",
 		rlHttpClient,
 		"GET",
@@ -627,32 +771,115 @@ func TestCall_PermanentError(t *testing.T) {
 	}
 }
 
-// Helper function to compare maps
-func equalMaps(a, b map[string]any) bool {
-	if len(a) != len(b) {
-		return false
+func TestCall_ContextCanceledDuringRateLimiterWait(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// Drain the only token so the next call has to wait on the limiter
+	rl := rate.NewLimiter(rate.Every(time.Hour), 1)
+	rl.Allow()
+	rlHttpClient := NewRlHttpClient(rl, 5000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := Call(
+		ctx,
+		"test_api_key",
+		rlHttpClient,
+		"GET",
+		server.URL+"/test",
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	if err == nil {
+		t.Fatal("Expected error for canceled context")
 	}
-	
-	for k, v := range a {
-		if bv, exists := b[k]; !exists {
-			return false
-		} else if !equalValues(v, bv) {
-			return false
-		}
+	if time.Since(start) > time.Second {
+		t.Errorf("Call did not return promptly after cancellation")
+	}
+	if requestCount != 0 {
+		t.Errorf("Expected no request to reach the server, got %d", requestCount)
 	}
-	
-	return true
 }
 
-// Helper function to compare interface{} values
-func equalValues(a, b any) bool {
-	// Handle nested maps
-	if aMap, aOk := a.(map[string]any); aOk {
-		if bMap, bOk := b.(map[string]any); bOk {
-			return equalMaps(aMap, bMap)
+func TestCall_ContextCanceledDuringBackoff(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	rl := rate.NewLimiter(rate.Every(1*time.Millisecond), 100)
+	rlHttpClient := NewRlHttpClient(rl, 5000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := Call(
+		ctx,
+		"test_api_key",
+		rlHttpClient,
+		"GET",
+		server.URL+"/test",
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > 2*time.Second {
+		t.Errorf("Backoff did not stop after cancellation")
+	}
+	if requestCount == 0 {
+		t.Error("Expected at least one request before cancellation")
+	}
+}
+
+func TestCallInternal_ContextCanceledDuringRequest(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
 		}
-		return false
+	}))
+	defer server.Close()
+	defer close(release)
+
+	rl := rate.NewLimiter(rate.Every(1*time.Millisecond), 100)
+	rlHttpClient := NewRlHttpClient(rl, 5000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := callInternal(
+		ctx,
+		"test_api_key",
+		rlHttpClient,
+		"GET",
+		server.URL+"/test",
+		nil,
+		nil,
+		nil,
+		nil,
+		[][2]string{},
+	)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
 	}
-	
-	return a == b
-}
\ No newline at end of file
+}
@@ -0,0 +1,198 @@
+package netwrk
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// queryField is a struct field as seen by encodeQuery.
+type queryField struct {
+	name      string
+	omitEmpty bool
+	comma     bool
+}
+
+// parseQueryTag reads the query tag of field. Fields without one fall back
+// to the name and omitempty option of their json tag and are comma joined.
+// It reports false for fields that are never sent.
+func parseQueryTag(field reflect.StructField) (queryField, bool) {
+	tag, ok := field.Tag.Lookup("query")
+	fallback := !ok
+	if fallback {
+		tag = field.Tag.Get("json")
+	}
+	if tag == "-" {
+		return queryField{}, false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	f := queryField{name: name, comma: fallback}
+	for _, opt := range strings.Split(opts, ",") {
+		switch opt {
+		case "omitempty":
+			f.omitEmpty = true
+		case "comma":
+			f.comma = true
+		}
+	}
+	return f, true
+}
+
+// encodeQuery encodes v, a struct or a pointer to one, into query values.
+// Fields are named and tuned by their query tag:
+//
+//	query:"name"             always sent, zero values included
+//	query:"name,omitempty"   left out when zero or empty
+//	query:"name,comma"       a slice sent as one comma separated value
+//	                         rather than as a repeated parameter
+//	query:"-"                never sent
+//
+// Fields without a query tag use their json tag. Strings, bools, numbers,
+// time.Time (as epoch milliseconds), pointers to them and slices of them
+// are supported, and untagged embedded structs are flattened. A comma field
+// sharing its name with an earlier field is joined onto it, so a comma
+// separated string and its typed slice can be combined. nil encodes to no
+// values.
+func encodeQuery(v any) (url.Values, error) {
+	q := url.Values{}
+	if values, ok := v.(url.Values); ok {
+		for k, vs := range values {
+			q[k] = append([]string(nil), vs...)
+		}
+		return q, nil
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return q, nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return q, nil
+	}
+	if rv.Kind() != reflect.Struct {
+		return q, fmt.Errorf("query parameters must be a struct, got %T", v)
+	}
+	return q, encodeQueryStruct(q, rv)
+}
+
+func encodeQueryStruct(q url.Values, rv reflect.Value) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		fv := rv.Field(i)
+		f, ok := parseQueryTag(field)
+		if !ok {
+			continue
+		}
+		if field.Anonymous && f.name == "" {
+			embedded := fv
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct && embedded.Type() != timeType {
+				if err := encodeQueryStruct(q, embedded); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if f.name == "" {
+			f.name = field.Name
+		}
+		values, err := queryValues(fv)
+		if err != nil {
+			return fmt.Errorf("query parameter %s: %w", f.name, err)
+		}
+		if f.omitEmpty && (fv.IsZero() || len(values) == 0) {
+			continue
+		}
+		if f.comma && len(values) > 0 {
+			values = []string{strings.Join(values, ",")}
+		}
+		if existing := q.Get(f.name); f.comma && existing != "" {
+			if len(values) > 0 && values[0] != "" {
+				q.Set(f.name, existing+","+values[0])
+			}
+			continue
+		}
+		if len(values) == 0 {
+			values = []string{""}
+		}
+		q[f.name] = append(q[f.name], values...)
+	}
+	return nil
+}
+
+// queryValues formats a field value, one string per slice element. Nil
+// pointers and empty slices format to no values.
+func queryValues(fv reflect.Value) ([]string, error) {
+	for fv.Kind() == reflect.Pointer || fv.Kind() == reflect.Interface {
+		if fv.IsNil() {
+			return nil, nil
+		}
+		fv = fv.Elem()
+	}
+	if fv.Kind() == reflect.Slice || fv.Kind() == reflect.Array {
+		values := make([]string, 0, fv.Len())
+		for i := 0; i < fv.Len(); i++ {
+			elem := fv.Index(i)
+			for elem.Kind() == reflect.Pointer || elem.Kind() == reflect.Interface {
+				if elem.IsNil() {
+					break
+				}
+				elem = elem.Elem()
+			}
+			if elem.Kind() == reflect.Slice || elem.Kind() == reflect.Array {
+				return nil, fmt.Errorf("nested %s is not supported", elem.Type())
+			}
+			value, err := queryValues(elem)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value...)
+		}
+		return values, nil
+	}
+	value, err := queryScalar(fv)
+	if err != nil {
+		return nil, err
+	}
+	return []string{value}, nil
+}
+
+func queryScalar(fv reflect.Value) (string, error) {
+	if fv.Type() == timeType {
+		t := fv.Interface().(time.Time)
+		if t.IsZero() {
+			return "", nil
+		}
+		return strconv.FormatInt(t.UnixMilli(), 10), nil
+	}
+	switch fv.Kind() {
+	case reflect.String:
+		return fv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(fv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(fv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(fv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(fv.Float(), 'f', -1, fv.Type().Bits()), nil
+	default:
+		return "", fmt.Errorf("%s is not supported", fv.Type())
+	}
+}
@@ -0,0 +1,388 @@
+package netwrk
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	onfleet "github.com/onfleet/gonfleet"
+)
+
+func TestEncodeQuery(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	name := "Alice"
+	active := true
+
+	type Embedded struct {
+		Page int `query:"page,omitempty"`
+	}
+
+	tests := []struct {
+		name     string
+		params   any
+		expected url.Values
+	}{
+		{
+			name:     "nil params",
+			params:   nil,
+			expected: url.Values{},
+		},
+		{
+			name:     "nil pointer",
+			params:   (*onfleet.TaskListQueryParams)(nil),
+			expected: url.Values{},
+		},
+		{
+			name:     "url values",
+			params:   url.Values{"a": {"1", "2"}},
+			expected: url.Values{"a": {"1", "2"}},
+		},
+		{
+			name: "scalars",
+			params: struct {
+				From   int64   `query:"from,omitempty"`
+				Radius float64 `query:"radius,omitempty"`
+				Count  uint8   `query:"count"`
+				Empty  string  `query:"empty,omitempty"`
+				Kept   string  `query:"kept"`
+				Hidden string  `query:"-"`
+			}{From: 1640995200000, Radius: 1.5, Hidden: "x"},
+			expected: url.Values{
+				"from":   {"1640995200000"},
+				"radius": {"1.5"},
+				"count":  {"0"},
+				"kept":   {""},
+			},
+		},
+		{
+			name: "bools",
+			params: struct {
+				Always  bool `query:"always"`
+				Omitted bool `query:"omitted,omitempty"`
+				Set     bool `query:"set,omitempty"`
+			}{Set: true},
+			expected: url.Values{
+				"always": {"false"},
+				"set":    {"true"},
+			},
+		},
+		{
+			name: "pointers",
+			params: struct {
+				Name   *string `query:"name,omitempty"`
+				None   *string `query:"none,omitempty"`
+				Active *bool   `query:"active,omitempty"`
+			}{Name: &name, Active: &active},
+			expected: url.Values{
+				"name":   {"Alice"},
+				"active": {"true"},
+			},
+		},
+		{
+			name: "times",
+			params: struct {
+				At   time.Time  `query:"at,omitempty"`
+				Ptr  *time.Time `query:"ptr,omitempty"`
+				Zero time.Time  `query:"zero,omitempty"`
+			}{At: at, Ptr: &at},
+			expected: url.Values{
+				"at":  {"1704067200000"},
+				"ptr": {"1704067200000"},
+			},
+		},
+		{
+			name: "slices",
+			params: struct {
+				Repeated []string            `query:"repeated,omitempty"`
+				Comma    []onfleet.TaskState `query:"comma,omitempty,comma"`
+				Times    []time.Time         `query:"times,comma"`
+				None     []string            `query:"none,omitempty,comma"`
+			}{
+				Repeated: []string{"a", "b"},
+				Comma:    []onfleet.TaskState{onfleet.TaskStateUnassigned, onfleet.TaskStateAssigned},
+				Times:    []time.Time{at, at.Add(time.Second)},
+			},
+			expected: url.Values{
+				"repeated": {"a", "b"},
+				"comma":    {"0,1"},
+				"times":    {"1704067200000,1704067201000"},
+			},
+		},
+		{
+			name: "embedded struct",
+			params: struct {
+				Embedded
+				Name string `query:"name"`
+			}{Embedded: Embedded{Page: 2}, Name: "John"},
+			expected: url.Values{
+				"page": {"2"},
+				"name": {"John"},
+			},
+		},
+		{
+			name: "embedded pointer struct",
+			params: struct {
+				*Embedded
+				Name string `query:"name"`
+			}{Embedded: &Embedded{Page: 3}, Name: "John"},
+			expected: url.Values{
+				"page": {"3"},
+				"name": {"John"},
+			},
+		},
+		{
+			name: "json tag fallback",
+			params: struct {
+				Name string   `json:"name,omitempty"`
+				Ids  []string `json:"ids,omitempty"`
+				None string   `json:"none,omitempty"`
+				Skip string   `json:"-"`
+			}{Name: "John", Ids: []string{"a", "b"}, Skip: "x"},
+			expected: url.Values{
+				"name": {"John"},
+				"ids":  {"a,b"},
+			},
+		},
+		{
+			name: "comma field joined onto earlier field",
+			params: struct {
+				Legacy string   `query:"ids,omitempty"`
+				Typed  []string `query:"ids,omitempty,comma"`
+			}{Legacy: "a,b", Typed: []string{"c"}},
+			expected: url.Values{
+				"ids": {"a,b,c"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := encodeQuery(tt.params)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Encode() != tt.expected.Encode() {
+				t.Errorf("Expected %s, got %s", tt.expected.Encode(), result.Encode())
+			}
+		})
+	}
+}
+
+func TestEncodeQuery_Unsupported(t *testing.T) {
+	tests := []struct {
+		name   string
+		params any
+	}{
+		{name: "not a struct", params: map[string]string{"a": "1"}},
+		{name: "nested struct", params: struct {
+			User struct {
+				Name string `query:"name"`
+			} `query:"user"`
+		}{}},
+		{name: "map field", params: struct {
+			Tags map[string]string `query:"tags"`
+		}{}},
+		{name: "nested slice", params: struct {
+			Ids [][]string `query:"ids"`
+		}{Ids: [][]string{{"a"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := encodeQuery(tt.params); err == nil {
+				t.Error("Expected error but got none")
+			}
+		})
+	}
+}
+
+func TestEncodeQuery_QueryParamsTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   any
+		expected string
+	}{
+		{
+			name:     "RoutePlanListQueryParams empty",
+			params:   onfleet.RoutePlanListQueryParams{},
+			expected: "",
+		},
+		{
+			name: "RoutePlanListQueryParams",
+			params: onfleet.RoutePlanListQueryParams{
+				WorkerId:        "worker_123",
+				StartTimeTo:     1672531199000,
+				StartTimeFrom:   1640995200000,
+				CreatedTimeTo:   1672531199001,
+				CreatedTimeFrom: 1640995200001,
+				HasTasks:        true,
+				Limit:           20,
+			},
+			expected: "createdTimeFrom=1640995200001&createdTimeTo=1672531199001&hasTasks=true&limit=20&startTimeFrom=1640995200000&startTimeTo=1672531199000&workerId=worker_123",
+		},
+		{
+			name:     "WorkerGetQueryParams empty",
+			params:   &onfleet.WorkerGetQueryParams{},
+			expected: "",
+		},
+		{
+			name: "WorkerGetQueryParams",
+			params: &onfleet.WorkerGetQueryParams{
+				Analytics: true,
+				Filter:    "name,phone",
+				From:      1640995200000,
+				To:        1672531199000,
+			},
+			expected: "analytics=true&filter=name%2Cphone&from=1640995200000&to=1672531199000",
+		},
+		{
+			name:     "WorkerListQueryParams empty",
+			params:   &onfleet.WorkerListQueryParams{},
+			expected: "",
+		},
+		{
+			name: "WorkerListQueryParams",
+			params: &onfleet.WorkerListQueryParams{
+				Filter:       "name",
+				Phones:       "+15555550100",
+				PhoneNumbers: []string{"+15555550101"},
+				States:       "0",
+				WorkerStates: []onfleet.WorkerState{onfleet.WorkerStateIdle, onfleet.WorkerStateActive},
+				TeamIds:      []string{"team_1", "team_2"},
+			},
+			expected: "filter=name&phones=%2B15555550100%2C%2B15555550101&states=0%2C1%2C2&teams=team_1%2Cteam_2",
+		},
+		{
+			name:     "WorkersByLocationListQueryParams empty",
+			params:   onfleet.WorkersByLocationListQueryParams{},
+			expected: "latitude=0&longitude=0",
+		},
+		{
+			name: "WorkersByLocationListQueryParams",
+			params: onfleet.WorkersByLocationListQueryParams{
+				Longitude: -122.4194,
+				Latitude:  37.7749,
+				Radius:    1000,
+			},
+			expected: "latitude=37.7749&longitude=-122.4194&radius=1000",
+		},
+		{
+			name:     "WorkerTasksListQueryParams empty",
+			params:   &onfleet.WorkerTasksListQueryParams{},
+			expected: "",
+		},
+		{
+			name: "WorkerTasksListQueryParams",
+			params: &onfleet.WorkerTasksListQueryParams{
+				From:         1640995200000,
+				IsPickupTask: "false",
+				LastId:       "task_123",
+				To:           1672531199000,
+			},
+			expected: "from=1640995200000&isPickupTask=false&lastId=task_123&to=1672531199000",
+		},
+		{
+			name:     "TeamWorkerEtaQueryParams empty",
+			params:   &onfleet.TeamWorkerEtaQueryParams{},
+			expected: "",
+		},
+		{
+			name: "TeamWorkerEtaQueryParams",
+			params: &onfleet.TeamWorkerEtaQueryParams{
+				DropoffLocation:         "-122.4194,37.7749",
+				PickupLocation:          "-122.4,37.7",
+				PickupTime:              1640995200,
+				RestrictedVehiclesTypes: onfleet.WorkerVehicleTypeCar,
+				ServiceTime:             2.5,
+			},
+			expected: "dropoffLocation=-122.4194%2C37.7749&pickupLocation=-122.4%2C37.7&pickupTime=1640995200&restrictedVehiclesTypes=CAR&serviceTime=2.5",
+		},
+		{
+			name:     "TeamTasksListQueryParams empty",
+			params:   &onfleet.TeamTasksListQueryParams{},
+			expected: "",
+		},
+		{
+			name: "TeamTasksListQueryParams",
+			params: &onfleet.TeamTasksListQueryParams{
+				From:         1640995200000,
+				IsPickupTask: "true",
+				LastId:       "task_123",
+				To:           1672531199000,
+			},
+			expected: "from=1640995200000&isPickupTask=true&lastId=task_123&to=1672531199000",
+		},
+		{
+			name:     "TaskListQueryParams empty",
+			params:   onfleet.TaskListQueryParams{},
+			expected: "",
+		},
+		{
+			name: "TaskListQueryParams",
+			params: onfleet.TaskListQueryParams{
+				From:                 1640995200000,
+				To:                   1672531199000,
+				LastId:               "task_123",
+				State:                "0",
+				States:               []onfleet.TaskState{onfleet.TaskStateActive, onfleet.TaskStateCompleted},
+				Worker:               "w1",
+				WorkerIds:            []string{"w2"},
+				CompleteBeforeBefore: 1672531199001,
+				CompleteAfterAfter:   1640995200001,
+				DependencyIds:        []string{"t1", "t2"},
+				Containers:           "c1",
+			},
+			expected: "completeAfterAfter=1640995200001&completeBeforeBefore=1672531199001&containers=c1&dependencies=t1%2Ct2&from=1640995200000&lastId=task_123&state=0%2C2%2C3&to=1672531199000&worker=w1%2Cw2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := encodeQuery(tt.params)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Encode() != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result.Encode())
+			}
+		})
+	}
+}
+
+func TestUrlAttachQuery_TypedFilters(t *testing.T) {
+	result, err := urlAttachQuery("https://api.example.com/tasks/all", onfleet.TaskListQueryParams{
+		From:   1640995200000,
+		States: []onfleet.TaskState{onfleet.TaskStateUnassigned, onfleet.TaskStateAssigned},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "https://api.example.com/tasks/all?from=1640995200000&state=0%2C1"
+	if result != expected {
+		t.Errorf("Expected %s, got %s", expected, result)
+	}
+}
+
+func TestCallInternal_UnsupportedQueryParams(t *testing.T) {
+	rlHttpClient := NewRlHttpClient(NewRateLimiter(10), 5000)
+
+	_, err := callInternal(
+		context.Background(),
+		"test_api_key",
+		rlHttpClient,
+		"GET",
+		"http://127.0.0.1:0",
+		[]string{"test"},
+		map[string]string{"a": "1"},
+		nil,
+		nil,
+		nil,
+	)
+
+	if err == nil || !strings.Contains(err.Error(), "query parameters must be a struct") {
+		t.Errorf("Expected query encoding error, got %v", err)
+	}
+}
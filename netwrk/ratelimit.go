@@ -0,0 +1,207 @@
+package netwrk
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Onfleet rate limit response headers.
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// RateLimitObserver is implemented by limiters that adapt to the rate limit
+// headers Onfleet returns on each response.
+type RateLimitObserver interface {
+	ObserveRateLimit(statusCode int, header http.Header)
+}
+
+// RateLimitStatus is a snapshot of an AdaptiveRateLimiter.
+type RateLimitStatus struct {
+	// Limit, Remaining and Reset are the values last reported by Onfleet.
+	// They are zero until a response carrying the headers is observed.
+	Limit     int
+	Remaining int
+	Reset     time.Time
+	// CurrentRate is the number of calls per second currently allowed.
+	CurrentRate float64
+	// BaseRate is the configured rate the limiter ramps back up to.
+	BaseRate float64
+	// Throttled is true while CurrentRate is below BaseRate.
+	Throttled bool
+	// UpdatedAt is when the last response was observed.
+	UpdatedAt time.Time
+}
+
+// AdaptiveRateLimiter is a RateLimiter that slows down when Onfleet reports
+// that few calls remain in the current window or rejects a call with a rate
+// limit response, and ramps back up to its base rate while traffic is healthy.
+// When no calls remain, it blocks until the window resets.
+type AdaptiveRateLimiter struct {
+	mu        sync.Mutex
+	limiter   *rate.Limiter
+	baseRate  rate.Limit
+	baseBurst int
+	// minRate is the floor of halving on rate limit responses. Rates paced
+	// from the remaining calls may go below it.
+	minRate      rate.Limit
+	blockedUntil time.Time
+	status       RateLimitStatus
+	now          func() time.Time
+}
+
+// NewAdaptiveRateLimiter returns an AdaptiveRateLimiter allowing up to
+// callsPerSecond calls per second.
+func NewAdaptiveRateLimiter(callsPerSecond int) *AdaptiveRateLimiter {
+	limiter := NewRateLimiter(callsPerSecond)
+	return &AdaptiveRateLimiter{
+		limiter:   limiter,
+		baseRate:  limiter.Limit(),
+		baseBurst: limiter.Burst(),
+		minRate:   1,
+		status: RateLimitStatus{
+			CurrentRate: float64(limiter.Limit()),
+			BaseRate:    float64(limiter.Limit()),
+		},
+		now: time.Now,
+	}
+}
+
+func (l *AdaptiveRateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	wait := l.blockedUntil.Sub(l.now())
+	l.mu.Unlock()
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return l.limiter.Wait(ctx)
+}
+
+// Status returns the current state of the limiter. CurrentRate is zero
+// while the limiter blocks until the window resets.
+func (l *AdaptiveRateLimiter) Status() RateLimitStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	status := l.status
+	if l.now().Before(l.blockedUntil) {
+		status.CurrentRate = 0
+		status.Throttled = true
+	}
+	return status
+}
+
+// ObserveRateLimit adjusts the limiter from a response status and headers.
+func (l *AdaptiveRateLimiter) ObserveRateLimit(statusCode int, header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.status.UpdatedAt = now
+	limit, hasLimit := headerInt(header, headerRateLimitLimit)
+	remaining, hasRemaining := headerInt(header, headerRateLimitRemaining)
+	reset := parseRateLimitReset(header.Get(headerRateLimitReset), now)
+	if hasLimit {
+		l.status.Limit = limit
+	}
+	if hasRemaining {
+		l.status.Remaining = remaining
+	}
+	if !reset.IsZero() {
+		l.status.Reset = reset
+	}
+
+	l.blockedUntil = time.Time{}
+	current := l.limiter.Limit()
+	switch {
+	case isRateLimitStatus(statusCode):
+		current = max(current/2, l.minRate)
+	case hasRemaining && remaining <= 0 && reset.After(now):
+		// nothing left to spread; wait for the window to reset
+		l.blockedUntil = reset
+		return
+	case hasRemaining && l.isLow(remaining, limit, hasLimit):
+		// spread what is left of the window evenly until it resets
+		window := time.Second
+		if !reset.IsZero() && reset.After(now) {
+			window = reset.Sub(now)
+		}
+		paced := rate.Limit(float64(remaining) / window.Seconds())
+		if remaining <= 0 {
+			// the reset is unknown or past, probe slowly
+			paced = l.minRate
+		}
+		if paced < current {
+			current = paced
+		}
+	default:
+		current += l.baseRate / 10
+	}
+	l.setRate(current)
+}
+
+// isLow reports whether remaining is within the last quarter of the window.
+func (l *AdaptiveRateLimiter) isLow(remaining int, limit int, hasLimit bool) bool {
+	if hasLimit && limit > 0 {
+		return remaining*4 <= limit
+	}
+	return remaining <= l.baseBurst/4
+}
+
+func (l *AdaptiveRateLimiter) setRate(r rate.Limit) {
+	if r > l.baseRate {
+		r = l.baseRate
+	}
+	l.limiter.SetLimit(r)
+	if r < l.baseRate {
+		l.limiter.SetBurst(1)
+	} else {
+		l.limiter.SetBurst(l.baseBurst)
+	}
+	l.status.CurrentRate = float64(r)
+	l.status.Throttled = r < l.baseRate
+}
+
+func headerInt(header http.Header, key string) (int, bool) {
+	value := header.Get(key)
+	if value == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// parseRateLimitReset reads X-RateLimit-Reset given as epoch milliseconds,
+// epoch seconds or seconds from now.
+func parseRateLimitReset(value string, now time.Time) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return time.Time{}
+	}
+	switch {
+	case n > 1e12:
+		return time.UnixMilli(n)
+	case n > 1e9:
+		return time.Unix(n, 0)
+	default:
+		return now.Add(time.Duration(n) * time.Second)
+	}
+}
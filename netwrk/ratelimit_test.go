@@ -0,0 +1,188 @@
+package netwrk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func rateLimitHeader(limit, remaining int, reset string) http.Header {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	if reset != "" {
+		header.Set("X-RateLimit-Reset", reset)
+	}
+	return header
+}
+
+func TestNewAdaptiveRateLimiter(t *testing.T) {
+	l := NewAdaptiveRateLimiter(18)
+	status := l.Status()
+
+	if status.BaseRate != 18 || status.CurrentRate != 18 {
+		t.Errorf("Expected base and current rate of 18, got %v and %v", status.BaseRate, status.CurrentRate)
+	}
+	if status.Throttled {
+		t.Error("Expected new limiter not to be throttled")
+	}
+	if l.limiter.Burst() != 18 {
+		t.Errorf("Expected burst of 18, got %d", l.limiter.Burst())
+	}
+}
+
+func TestAdaptiveRateLimiter_SlowsDownWhenRemainingIsLow(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewAdaptiveRateLimiter(20)
+	l.now = func() time.Time { return now }
+
+	reset := strconv.FormatInt(now.Add(2*time.Second).Unix(), 10)
+	l.ObserveRateLimit(http.StatusOK, rateLimitHeader(20, 4, reset))
+	status := l.Status()
+
+	if status.CurrentRate != 2 {
+		t.Errorf("Expected 4 remaining calls over 2s to pace at 2/s, got %v", status.CurrentRate)
+	}
+	if !status.Throttled {
+		t.Error("Expected limiter to be throttled")
+	}
+	if status.Limit != 20 || status.Remaining != 4 || !status.Reset.Equal(now.Add(2*time.Second)) {
+		t.Errorf("Unexpected reported values %+v", status)
+	}
+	if l.limiter.Burst() != 1 {
+		t.Errorf("Expected burst of 1 while throttled, got %d", l.limiter.Burst())
+	}
+}
+
+func TestAdaptiveRateLimiter_PacesBelowOneCallPerSecond(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewAdaptiveRateLimiter(20)
+	l.now = func() time.Time { return now }
+
+	reset := strconv.FormatInt(now.Add(30*time.Second).Unix(), 10)
+	l.ObserveRateLimit(http.StatusOK, rateLimitHeader(20, 3, reset))
+
+	if rate := l.Status().CurrentRate; rate != 0.1 {
+		t.Errorf("Expected 3 remaining calls over 30s to pace at 0.1/s, got %v", rate)
+	}
+}
+
+func TestAdaptiveRateLimiter_BlocksUntilResetWhenNoneRemain(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewAdaptiveRateLimiter(20)
+	l.now = func() time.Time { return now }
+
+	reset := strconv.FormatInt(now.Add(30*time.Second).Unix(), 10)
+	l.ObserveRateLimit(http.StatusOK, rateLimitHeader(20, 0, reset))
+	status := l.Status()
+
+	if status.CurrentRate != 0 || !status.Throttled {
+		t.Errorf("Expected no calls until reset, got %+v", status)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected Wait to block until reset, got %v", err)
+	}
+
+	now = now.Add(30 * time.Second)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("Expected Wait to pass after reset, got %v", err)
+	}
+	if status := l.Status(); status.CurrentRate != 20 || status.Throttled {
+		t.Errorf("Expected full rate after reset, got %+v", status)
+	}
+}
+
+func TestAdaptiveRateLimiter_HalvesOnRateLimitResponse(t *testing.T) {
+	l := NewAdaptiveRateLimiter(20)
+
+	l.ObserveRateLimit(http.StatusTooManyRequests, http.Header{})
+	if rate := l.Status().CurrentRate; rate != 10 {
+		t.Errorf("Expected rate of 10 after 429, got %v", rate)
+	}
+	l.ObserveRateLimit(http.StatusPreconditionFailed, http.Header{})
+	if rate := l.Status().CurrentRate; rate != 5 {
+		t.Errorf("Expected rate of 5 after 412, got %v", rate)
+	}
+	for i := 0; i < 10; i++ {
+		l.ObserveRateLimit(http.StatusTooManyRequests, http.Header{})
+	}
+	if rate := l.Status().CurrentRate; rate != 1 {
+		t.Errorf("Expected rate to bottom out at 1, got %v", rate)
+	}
+}
+
+func TestAdaptiveRateLimiter_RampsBackUp(t *testing.T) {
+	l := NewAdaptiveRateLimiter(20)
+	l.ObserveRateLimit(http.StatusTooManyRequests, http.Header{})
+
+	for i := 0; i < 4; i++ {
+		l.ObserveRateLimit(http.StatusOK, rateLimitHeader(20, 15, "1"))
+	}
+	if rate := l.Status().CurrentRate; rate != 18 {
+		t.Errorf("Expected rate to ramp up to 18, got %v", rate)
+	}
+	for i := 0; i < 4; i++ {
+		l.ObserveRateLimit(http.StatusOK, http.Header{})
+	}
+	status := l.Status()
+	if status.CurrentRate != 20 || status.Throttled {
+		t.Errorf("Expected full rate once healthy, got %+v", status)
+	}
+	if l.limiter.Burst() != 20 {
+		t.Errorf("Expected burst to be restored, got %d", l.limiter.Burst())
+	}
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Time
+	}{
+		{name: "empty", value: "", expected: time.Time{}},
+		{name: "invalid", value: "later", expected: time.Time{}},
+		{name: "delta seconds", value: "3", expected: now.Add(3 * time.Second)},
+		{name: "epoch seconds", value: "1700000005", expected: time.Unix(1700000005, 0)},
+		{name: "epoch milliseconds", value: "1700000005000", expected: time.UnixMilli(1700000005000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRateLimitReset(tt.value, now); !got.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCallInternal_ObservesRateLimitHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "20")
+		w.Header().Set("X-RateLimit-Remaining", "2")
+		w.Header().Set("X-RateLimit-Reset", "1")
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	l := NewAdaptiveRateLimiter(20)
+	rlHttpClient := NewRlHttpClient(l, 5000)
+
+	_, err := callInternal(context.Background(), "test_api_key", rlHttpClient, "GET", server.URL, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	status := rlHttpClient.RateLimitStatus()
+	if status.Remaining != 2 || status.Limit != 20 {
+		t.Errorf("Expected reported headers in status, got %+v", status)
+	}
+	if !status.Throttled {
+		t.Error("Expected limiter to slow down when few calls remain")
+	}
+}
@@ -0,0 +1,166 @@
+package netwrk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// RetryPolicy controls how Call retries a failed request.
+type RetryPolicy struct {
+	// MaxAttempts caps the number of attempts, including the first one.
+	// Zero means attempts are only bounded by MaxElapsedTime.
+	MaxAttempts int
+	// MaxElapsedTime caps the total time spent on a call, including waits
+	// between attempts. Zero means no limit.
+	MaxElapsedTime time.Duration
+	// InitialInterval is the wait before the first retry. Zero uses the
+	// backoff package default of 500ms.
+	InitialInterval time.Duration
+	// MaxInterval caps the wait between attempts computed by the exponential
+	// backoff. It does not cap a server provided Retry-After. Zero uses the
+	// backoff package default of 60s.
+	MaxInterval time.Duration
+	// RetryStatusCodes lists the HTTP response status codes that are retried.
+	RetryStatusCodes []int
+	// RetryNetworkErrors retries transient transport failures such as
+	// refused or reset connections, unexpected EOFs and timeouts.
+	RetryNetworkErrors bool
+	// IdempotentOnly restricts retries of non-idempotent (POST) requests to
+	// rate limit responses, which Onfleet rejects before doing any work.
+	IdempotentOnly bool
+}
+
+// DefaultRetryPolicy returns the policy used when none is provided.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxElapsedTime:  15 * time.Second,
+		InitialInterval: backoff.DefaultInitialInterval,
+		MaxInterval:     backoff.DefaultMaxInterval,
+		RetryStatusCodes: []int{
+			http.StatusPreconditionFailed,
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryNetworkErrors: true,
+		IdempotentOnly:     true,
+	}
+}
+
+type retryPolicyKey struct{}
+
+// WithRetryPolicy returns a copy of ctx that makes calls made with it use
+// policy instead of the one configured on the client.
+func WithRetryPolicy(ctx context.Context, policy *RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// retryPolicyFor resolves the policy for a call: per call override first,
+// then the client policy, then the default.
+func retryPolicyFor(ctx context.Context, rlHttpClient *RlHttpClient) *RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyKey{}).(*RetryPolicy); ok && policy != nil {
+		return policy
+	}
+	if rlHttpClient != nil && rlHttpClient.RetryPolicy != nil {
+		return rlHttpClient.RetryPolicy
+	}
+	return DefaultRetryPolicy()
+}
+
+func (p *RetryPolicy) newBackOff() *backoff.ExponentialBackOff {
+	b := backoff.NewExponentialBackOff()
+	if p.InitialInterval > 0 {
+		b.InitialInterval = p.InitialInterval
+	}
+	if p.MaxInterval > 0 {
+		b.MaxInterval = p.MaxInterval
+	}
+	// elapsed time is enforced by Call so that Retry-After waits count too
+	b.MaxElapsedTime = 0
+	b.Reset()
+	return b
+}
+
+// shouldRetry reports whether a failed attempt may be retried under p.
+func (p *RetryPolicy) shouldRetry(method string, res callResult, err error) bool {
+	if res.statusCode != 0 {
+		if res.statusCode >= 200 && res.statusCode <= 299 {
+			return false
+		}
+		if !p.retriesStatus(res.statusCode) {
+			return false
+		}
+		if isRateLimitStatus(res.statusCode) {
+			return true
+		}
+		return !p.IdempotentOnly || isIdempotent(method)
+	}
+	if p.RetryNetworkErrors && isTransientNetworkError(err) {
+		return !p.IdempotentOnly || isIdempotent(method)
+	}
+	return false
+}
+
+func (p *RetryPolicy) retriesStatus(statusCode int) bool {
+	for _, code := range p.RetryStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+func isRateLimitStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusPreconditionFailed
+}
+
+func isIdempotent(method string) bool {
+	return method != http.MethodPost
+}
+
+// isTransientNetworkError reports whether err is a transport failure that is
+// likely to succeed on a later attempt.
+func isTransientNetworkError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as
+// an HTTP date. It returns zero when the header is absent or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if d := at.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,238 @@
+package netwrk
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func newTestRlHttpClient(policy *RetryPolicy) *RlHttpClient {
+	rlHttpClient := NewRlHttpClient(rate.NewLimiter(rate.Every(1*time.Millisecond), 100), 5000)
+	rlHttpClient.RetryPolicy = policy
+	return rlHttpClient
+}
+
+func fastRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialInterval = time.Millisecond
+	policy.MaxInterval = 5 * time.Millisecond
+	return policy
+}
+
+func TestCall_RetriesServerErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+	}{
+		{name: "502 Bad Gateway", statusCode: http.StatusBadGateway},
+		{name: "503 Service Unavailable", statusCode: http.StatusServiceUnavailable},
+		{name: "504 Gateway Timeout", statusCode: http.StatusGatewayTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				if requestCount < 3 {
+					w.WriteHeader(tt.statusCode)
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]any{"success": true})
+			}))
+			defer server.Close()
+
+			var result map[string]any
+			err := Call(context.Background(), "test_api_key", newTestRlHttpClient(fastRetryPolicy()), "GET", server.URL, nil, nil, nil, &result)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if requestCount != 3 {
+				t.Errorf("Expected 3 requests, got %d", requestCount)
+			}
+			if result["success"] != true {
+				t.Errorf("Expected success=true, got %v", result["success"])
+			}
+		})
+	}
+}
+
+func TestCall_IdempotentOnlySkipsPostRetry(t *testing.T) {
+	tests := []struct {
+		name             string
+		statusCode       int
+		idempotentOnly   bool
+		expectedRequests int
+	}{
+		{name: "503 not retried", statusCode: http.StatusServiceUnavailable, idempotentOnly: true, expectedRequests: 1},
+		{name: "429 still retried", statusCode: http.StatusTooManyRequests, idempotentOnly: true, expectedRequests: 2},
+		{name: "503 retried when allowed", statusCode: http.StatusServiceUnavailable, idempotentOnly: false, expectedRequests: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				if requestCount == 1 {
+					w.WriteHeader(tt.statusCode)
+					return
+				}
+				w.Write([]byte("{}"))
+			}))
+			defer server.Close()
+
+			policy := fastRetryPolicy()
+			policy.IdempotentOnly = tt.idempotentOnly
+			Call(context.Background(), "test_api_key", newTestRlHttpClient(policy), "POST", server.URL, nil, nil, map[string]any{}, nil)
+
+			if requestCount != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, requestCount)
+			}
+		})
+	}
+}
+
+func TestCall_MaxAttempts(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	policy := fastRetryPolicy()
+	policy.MaxAttempts = 4
+	err := Call(context.Background(), "test_api_key", newTestRlHttpClient(policy), "GET", server.URL, nil, nil, nil, nil)
+
+	if err == nil {
+		t.Fatal("Expected error after exhausting attempts")
+	}
+	if requestCount != 4 {
+		t.Errorf("Expected 4 requests, got %d", requestCount)
+	}
+}
+
+func TestCall_HonorsRetryAfter(t *testing.T) {
+	var waits []time.Duration
+	defer func(original func(context.Context, time.Duration) error) { sleep = original }(sleep)
+	sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if requestCount == 1 {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	err := Call(context.Background(), "test_api_key", newTestRlHttpClient(fastRetryPolicy()), "GET", server.URL, nil, nil, nil, nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if requestCount != 2 {
+		t.Fatalf("Expected 2 requests, got %d", requestCount)
+	}
+	if len(waits) != 1 || waits[0] != 7*time.Second {
+		t.Errorf("Expected retry to wait for Retry-After of 7s, waited %v", waits)
+	}
+}
+
+func TestCall_PerCallRetryPolicyOverride(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx := WithRetryPolicy(context.Background(), &RetryPolicy{MaxAttempts: 1})
+	err := Call(ctx, "test_api_key", newTestRlHttpClient(fastRetryPolicy()), "GET", server.URL, nil, nil, nil, nil)
+
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if requestCount != 1 {
+		t.Errorf("Expected 1 request with override policy, got %d", requestCount)
+	}
+}
+
+func TestCall_RetriesNetworkErrors(t *testing.T) {
+	// Reserve a port and close it so dialing it is refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	policy := fastRetryPolicy()
+	policy.MaxAttempts = 3
+	attempts := 0
+	rlHttpClient := newTestRlHttpClient(policy)
+	rlHttpClient.Client.Transport = roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	err = Call(context.Background(), "test_api_key", rlHttpClient, "GET", "http://"+addr, nil, nil, nil, nil)
+
+	if err == nil {
+		t.Fatal("Expected dial error")
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+
+	policy.RetryNetworkErrors = false
+	attempts = 0
+	Call(context.Background(), "test_api_key", rlHttpClient, "GET", "http://"+addr, nil, nil, nil, nil)
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt with network retries disabled, got %d", attempts)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{name: "empty", value: "", expected: 0},
+		{name: "seconds", value: "3", expected: 3 * time.Second},
+		{name: "negative seconds", value: "-3", expected: 0},
+		{name: "http date", value: now.Add(5 * time.Second).Format(http.TimeFormat), expected: 5 * time.Second},
+		{name: "date in the past", value: now.Add(-5 * time.Second).Format(http.TimeFormat), expected: 0},
+		{name: "garbage", value: "soon", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
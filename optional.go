@@ -0,0 +1,106 @@
+package onfleet
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Optional is a field of an update params struct such as TaskUpdateParams.
+// The zero Optional is unset and leaves the field out of the request. Use
+// Set to send a value, including a zero value, and Null to clear the field.
+type Optional[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+// Set returns an Optional that sends v.
+func Set[T any](v T) Optional[T] {
+	return Optional[T]{value: v, set: true}
+}
+
+// Null returns an Optional that sends null, clearing the field.
+func Null[T any]() Optional[T] {
+	return Optional[T]{set: true, null: true}
+}
+
+// IsSet reports whether o is sent, either as a value or as null.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsNull reports whether o is sent as null.
+func (o Optional[T]) IsNull() bool {
+	return o.null
+}
+
+// Value returns the value of o and whether o is set to a value.
+func (o Optional[T]) Value() (T, bool) {
+	return o.value, o.set && !o.null
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set || o.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Set(v)
+	return nil
+}
+
+// optional is implemented by every Optional.
+type optional interface {
+	IsSet() bool
+}
+
+// marshalSetFields encodes the struct v as a JSON object, leaving out the
+// Optional fields that are not set.
+func marshalSetFields(v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	rt := rv.Type()
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		value := rv.Field(i).Interface()
+		if opt, ok := value.(optional); ok && !opt.IsSet() {
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(data)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
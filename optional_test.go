@@ -0,0 +1,73 @@
+package onfleet
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptional(t *testing.T) {
+	var unset Optional[string]
+	assert.False(t, unset.IsSet())
+
+	value, ok := Set("").Value()
+	assert.True(t, ok)
+	assert.Equal(t, "", value)
+
+	null := Null[string]()
+	assert.True(t, null.IsSet())
+	assert.True(t, null.IsNull())
+	_, ok = null.Value()
+	assert.False(t, ok)
+}
+
+func TestTaskUpdateParams_MarshalJSON(t *testing.T) {
+	params := TaskUpdateParams{
+		Notes:          Set("new notes"),
+		CompleteBefore: Null[int64](),
+		PickupTask:     Set(false),
+		Quantity:       Set(0.0),
+	}
+
+	raw, err := json.Marshal(params)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"completeBefore":null,"notes":"new notes","pickupTask":false,"quantity":0}`, string(raw))
+
+	raw, err = json.Marshal(TaskUpdateParams{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(raw))
+}
+
+func TestTaskUpdateParams_UnmarshalJSON(t *testing.T) {
+	var params TaskUpdateParams
+
+	err := json.Unmarshal([]byte(`{"notes":null,"quantity":2}`), &params)
+
+	assert.NoError(t, err)
+	assert.True(t, params.Notes.IsNull())
+	assert.Equal(t, Set(2.0), params.Quantity)
+	assert.False(t, params.Executor.IsSet())
+}
+
+func TestTaskParams_UpdateParams(t *testing.T) {
+	raw, err := json.Marshal(TaskParams{Notes: "notes", Quantity: 2}.UpdateParams())
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"notes":"notes","quantity":2}`, string(raw))
+}
+
+func TestTaskUpdateParams_Validate(t *testing.T) {
+	assert.NoError(t, TaskUpdateParams{CompleteBefore: Null[int64](), Destination: Set(DestinationID("dest_123"))}.Validate())
+
+	err := TaskUpdateParams{
+		CompleteAfter:  Set[int64](1641006000001),
+		CompleteBefore: Set[int64](1641006000000),
+		Recipients:     Set(NewRecipients(RecipientCreateParams{Name: "Bob"})),
+	}.Validate()
+
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.Equal(t, []string{"completeAfter", "recipients[0].phone"}, fieldsOf(err))
+}
@@ -0,0 +1,141 @@
+// Package pagination walks Onfleet endpoints that page their results with a
+// lastId cursor, and other sources that return items page by page.
+package pagination
+
+import (
+	"context"
+	"fmt"
+)
+
+// FetchFunc fetches the page that follows lastId, the first page when
+// lastId is empty. It returns the items of the page and the lastId of the
+// next page, empty when there are no more pages.
+type FetchFunc[T any] func(ctx context.Context, lastId string) (items []T, nextLastId string, err error)
+
+// PageFunc fetches the next page of a source that keeps track of its own
+// position. It returns the items of the page and whether more pages follow.
+type PageFunc[T any] func(ctx context.Context) (items []T, more bool, err error)
+
+// Paginator iterates over the items of a paginated source, fetching pages
+// lazily as they are consumed. Stopping early fetches no
+// further pages.
+//
+// The context handed to the fetch function is canceled once Next returns
+// false or Close is called, which stops any work the fetch function started
+// ahead of the consumer.
+//
+//	p := pagination.New(ctx, "", fetch, 0)
+//	for p.Next() {
+//		item := p.Item()
+//	}
+//	if err := p.Err(); err != nil {
+//		// handle error
+//	}
+type Paginator[T any] struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	next     PageFunc[T]
+	maxItems int
+	page     []T
+	current  T
+	seen     int
+	done     bool
+	err      error
+}
+
+// New returns a Paginator that starts after lastId, from the first page
+// when lastId is empty. maxItems caps the number of items returned; zero
+// means no cap.
+func New[T any](ctx context.Context, lastId string, fetch FetchFunc[T], maxItems int) *Paginator[T] {
+	next := func(ctx context.Context) ([]T, bool, error) {
+		items, nextLastId, err := fetch(ctx, lastId)
+		if err != nil || nextLastId == "" {
+			return items, false, err
+		}
+		if nextLastId == lastId {
+			return nil, false, fmt.Errorf("pagination: lastId %q did not advance", nextLastId)
+		}
+		lastId = nextLastId
+		return items, true, nil
+	}
+	return NewPaged(ctx, next, maxItems)
+}
+
+// NewPaged returns a Paginator over the pages returned by next, for sources
+// that are not paginated with a lastId cursor. maxItems caps the number of
+// items returned; zero means no cap.
+func NewPaged[T any](ctx context.Context, next PageFunc[T], maxItems int) *Paginator[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Paginator[T]{
+		ctx:      ctx,
+		cancel:   cancel,
+		next:     next,
+		maxItems: maxItems,
+	}
+}
+
+// Next advances to the next item, fetching the next page when needed. It
+// returns false when the items are exhausted, the cap is reached or an
+// error occurred.
+func (p *Paginator[T]) Next() bool {
+	if p.err != nil || (p.maxItems > 0 && p.seen >= p.maxItems) {
+		p.cancel()
+		return false
+	}
+	for len(p.page) == 0 {
+		if p.done {
+			p.cancel()
+			return false
+		}
+		if err := p.fetchPage(); err != nil {
+			p.err = err
+			p.cancel()
+			return false
+		}
+	}
+	p.current = p.page[0]
+	p.page = p.page[1:]
+	p.seen++
+	return true
+}
+
+// Item returns the item Next advanced to.
+func (p *Paginator[T]) Item() T {
+	return p.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// Close stops the iteration early and cancels the context handed to the
+// fetch function. Next returns false afterwards. Close is only needed when
+// the consumer stops before Next returns false.
+func (p *Paginator[T]) Close() {
+	p.page = nil
+	p.done = true
+	p.cancel()
+}
+
+// All consumes the remaining items and returns them.
+func (p *Paginator[T]) All() ([]T, error) {
+	items := []T{}
+	for p.Next() {
+		items = append(items, p.Item())
+	}
+	return items, p.Err()
+}
+
+func (p *Paginator[T]) fetchPage() error {
+	if err := p.ctx.Err(); err != nil {
+		return err
+	}
+	items, more, err := p.next(p.ctx)
+	if err != nil {
+		return err
+	}
+	p.page = items
+	p.done = !more
+	return nil
+}
@@ -0,0 +1,173 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type page struct {
+	items      []int
+	nextLastId string
+}
+
+func fetchPages(pages map[string]page, requests *[]string) FetchFunc[int] {
+	return func(ctx context.Context, lastId string) ([]int, string, error) {
+		*requests = append(*requests, lastId)
+		p, ok := pages[lastId]
+		if !ok {
+			return nil, "", fmt.Errorf("unexpected lastId %q", lastId)
+		}
+		return p.items, p.nextLastId, nil
+	}
+}
+
+func threePages() map[string]page {
+	return map[string]page{
+		"":  {items: []int{1, 2}, nextLastId: "2"},
+		"2": {items: []int{3, 4}, nextLastId: "4"},
+		"4": {items: []int{5}},
+	}
+}
+
+func TestPaginator(t *testing.T) {
+	var requests []string
+	p := New(context.Background(), "", fetchPages(threePages(), &requests), 0)
+
+	items, err := p.All()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	assert.Equal(t, []string{"", "2", "4"}, requests)
+	assert.False(t, p.Next())
+}
+
+func TestPaginator_StartLastId(t *testing.T) {
+	var requests []string
+	p := New(context.Background(), "2", fetchPages(threePages(), &requests), 0)
+
+	items, err := p.All()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4, 5}, items)
+	assert.Equal(t, []string{"2", "4"}, requests)
+}
+
+func TestPaginator_MaxItems(t *testing.T) {
+	var requests []string
+	p := New(context.Background(), "", fetchPages(threePages(), &requests), 3)
+
+	items, err := p.All()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.Equal(t, []string{"", "2"}, requests)
+}
+
+func TestPaginator_EarlyTermination(t *testing.T) {
+	var requests []string
+	p := New(context.Background(), "", fetchPages(threePages(), &requests), 0)
+
+	for p.Next() {
+		if p.Item() == 2 {
+			break
+		}
+	}
+
+	assert.NoError(t, p.Err())
+	assert.Equal(t, []string{""}, requests)
+}
+
+func TestPaginator_EmptyPagesAreSkipped(t *testing.T) {
+	var requests []string
+	pages := map[string]page{
+		"":  {items: []int{}, nextLastId: "a"},
+		"a": {items: []int{1}},
+	}
+	p := New(context.Background(), "", fetchPages(pages, &requests), 0)
+
+	items, err := p.All()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, items)
+}
+
+func TestPaginator_FetchError(t *testing.T) {
+	var requests []string
+	pages := threePages()
+	delete(pages, "4")
+	p := New(context.Background(), "", fetchPages(pages, &requests), 0)
+
+	items, err := p.All()
+
+	assert.Error(t, err)
+	assert.Equal(t, []int{1, 2, 3, 4}, items)
+	assert.False(t, p.Next())
+}
+
+func TestPaginator_LastIdNotAdvancing(t *testing.T) {
+	var requests []string
+	pages := map[string]page{
+		"":  {items: []int{1}, nextLastId: "1"},
+		"1": {items: []int{1}, nextLastId: "1"},
+	}
+	p := New(context.Background(), "", fetchPages(pages, &requests), 0)
+
+	_, err := p.All()
+
+	assert.Error(t, err)
+	assert.Len(t, requests, 2)
+}
+
+func TestPaginator_ContextCanceled(t *testing.T) {
+	var requests []string
+	ctx, cancel := context.WithCancel(context.Background())
+	p := New(ctx, "", fetchPages(threePages(), &requests), 0)
+
+	assert.True(t, p.Next())
+	cancel()
+	for p.Next() {
+	}
+
+	assert.True(t, errors.Is(p.Err(), context.Canceled))
+	assert.Equal(t, []string{""}, requests)
+}
+
+func TestPaginator_CancelsFetchContext(t *testing.T) {
+	var fetchCtx context.Context
+	fetch := func(ctx context.Context, lastId string) ([]int, string, error) {
+		fetchCtx = ctx
+		return []int{1, 2}, "2", nil
+	}
+
+	p := New(context.Background(), "", fetch, 1)
+	for p.Next() {
+	}
+	assert.ErrorIs(t, fetchCtx.Err(), context.Canceled)
+
+	p = New(context.Background(), "", fetch, 0)
+	assert.True(t, p.Next())
+	assert.NoError(t, fetchCtx.Err())
+	p.Close()
+	assert.ErrorIs(t, fetchCtx.Err(), context.Canceled)
+	assert.False(t, p.Next())
+	assert.NoError(t, p.Err())
+}
+
+func TestNewPaged(t *testing.T) {
+	pages := [][]int{{1, 2}, {}, {3}}
+	next := func(ctx context.Context) ([]int, bool, error) {
+		page := pages[0]
+		pages = pages[1:]
+		return page, len(pages) > 0, nil
+	}
+
+	items, err := NewPaged(context.Background(), next, 0).All()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.Empty(t, pages)
+}
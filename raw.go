@@ -0,0 +1,19 @@
+package onfleet
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// unmarshalKeepRaw decodes data into v when it is a JSON object and returns
+// a copy of data to keep as the Raw field of the decoded type. Other JSON
+// values, such as strings, are only kept raw. v must not implement
+// json.Unmarshaler.
+func unmarshalKeepRaw(data []byte, v any) (json.RawMessage, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		if err := json.Unmarshal(data, v); err != nil {
+			return nil, err
+		}
+	}
+	return append(json.RawMessage(nil), data...), nil
+}
@@ -0,0 +1,95 @@
+package onfleet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// DestinationRef is the destination of a task: either the ID of an existing
+// destination or a New destination to create with the task. Exactly one of
+// them must be set; build it with DestinationID or NewDestination.
+type DestinationRef struct {
+	ID  string
+	New *DestinationCreateParams
+}
+
+// DestinationID refers to an existing destination.
+func DestinationID(id string) *DestinationRef {
+	return &DestinationRef{ID: id}
+}
+
+// NewDestination creates params as the destination of the task.
+func NewDestination(params DestinationCreateParams) *DestinationRef {
+	return &DestinationRef{New: &params}
+}
+
+var errDestinationRef = errors.New("onfleet: DestinationRef must set exactly one of ID and New")
+
+func (r DestinationRef) MarshalJSON() ([]byte, error) {
+	if (r.ID == "") == (r.New == nil) {
+		return nil, errDestinationRef
+	}
+	if r.New != nil {
+		return json.Marshal(r.New)
+	}
+	return json.Marshal(r.ID)
+}
+
+func (r *DestinationRef) UnmarshalJSON(data []byte) error {
+	*r = DestinationRef{}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		return json.Unmarshal(data, &r.ID)
+	}
+	return json.Unmarshal(data, &r.New)
+}
+
+// RecipientRef is a recipient of a task: either the ID of an existing
+// recipient or a New recipient to create with the task. Exactly one of them
+// must be set.
+type RecipientRef struct {
+	ID  string
+	New *RecipientCreateParams
+}
+
+// RecipientRefs are the recipients of a task, built with RecipientIDs or
+// NewRecipients.
+type RecipientRefs []RecipientRef
+
+// RecipientIDs refers to existing recipients.
+func RecipientIDs(ids ...string) RecipientRefs {
+	refs := make(RecipientRefs, len(ids))
+	for i, id := range ids {
+		refs[i] = RecipientRef{ID: id}
+	}
+	return refs
+}
+
+// NewRecipients creates params as the recipients of the task.
+func NewRecipients(params ...RecipientCreateParams) RecipientRefs {
+	refs := make(RecipientRefs, len(params))
+	for i := range params {
+		refs[i] = RecipientRef{New: &params[i]}
+	}
+	return refs
+}
+
+var errRecipientRef = errors.New("onfleet: RecipientRef must set exactly one of ID and New")
+
+func (r RecipientRef) MarshalJSON() ([]byte, error) {
+	if (r.ID == "") == (r.New == nil) {
+		return nil, errRecipientRef
+	}
+	if r.New != nil {
+		return json.Marshal(r.New)
+	}
+	return json.Marshal(r.ID)
+}
+
+func (r *RecipientRef) UnmarshalJSON(data []byte) error {
+	*r = RecipientRef{}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		return json.Unmarshal(data, &r.ID)
+	}
+	return json.Unmarshal(data, &r.New)
+}
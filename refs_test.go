@@ -0,0 +1,63 @@
+package onfleet
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDestinationRef_JSON(t *testing.T) {
+	params := DestinationCreateParams{Address: DestinationAddress{Unparsed: "1 Main St"}}
+	paramsJSON, _ := json.Marshal(params)
+
+	tests := []struct {
+		name string
+		ref  *DestinationRef
+		json string
+	}{
+		{name: "id", ref: DestinationID("dest_123"), json: `"dest_123"`},
+		{name: "new", ref: NewDestination(params), json: string(paramsJSON)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := json.Marshal(tt.ref)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(raw))
+
+			var decoded DestinationRef
+			assert.NoError(t, json.Unmarshal(raw, &decoded))
+			assert.Equal(t, *tt.ref, decoded)
+		})
+	}
+}
+
+func TestDestinationRef_MarshalJSON_Invalid(t *testing.T) {
+	_, err := json.Marshal(TaskParams{Destination: &DestinationRef{}})
+	assert.ErrorContains(t, err, "exactly one of ID and New")
+
+	_, err = json.Marshal(TaskParams{Destination: &DestinationRef{ID: "dest_123", New: &DestinationCreateParams{}}})
+	assert.ErrorContains(t, err, "exactly one of ID and New")
+
+	raw, err := json.Marshal(TaskParams{})
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), "destination")
+}
+
+func TestRecipientRefs_JSON(t *testing.T) {
+	raw, err := json.Marshal(TaskParams{Recipients: RecipientIDs("rec_1", "rec_2")})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"pickupTask":false,"recipients":["rec_1","rec_2"]}`, string(raw))
+
+	raw, err = json.Marshal(NewRecipients(RecipientCreateParams{Name: "Bob", Phone: "+15551112222"}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"name":"Bob","phone":"+15551112222"}]`, string(raw))
+
+	var decoded RecipientRefs
+	assert.NoError(t, json.Unmarshal([]byte(`["rec_1",{"name":"Bob"}]`), &decoded))
+	assert.Equal(t, RecipientRefs{{ID: "rec_1"}, {New: &RecipientCreateParams{Name: "Bob"}}}, decoded)
+
+	_, err = json.Marshal(RecipientRefs{{}})
+	assert.ErrorContains(t, err, "exactly one of ID and New")
+}
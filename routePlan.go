@@ -46,13 +46,13 @@ type RoutePlan struct {
 }
 
 type RoutePlanListQueryParams struct {
-	WorkerId        string `json:"workerId,omitempty"`
-	StartTimeTo     int64  `json:"startTimeTo,omitempty"`
-	StartTimeFrom   int64  `json:"startTimeFrom,omitempty"`
-	CreatedTimeTo   int64  `json:"createdTimeTo,omitempty"`
-	CreatedTimeFrom int64  `json:"createdTimeFrom,omitempty"`
-	HasTasks        bool   `json:"hasTasks,omitempty"`
-	Limit           int64  `json:"limit,omitempty"`
+	WorkerId        string `json:"workerId,omitempty" query:"workerId,omitempty"`
+	StartTimeTo     int64  `json:"startTimeTo,omitempty" query:"startTimeTo,omitempty"`
+	StartTimeFrom   int64  `json:"startTimeFrom,omitempty" query:"startTimeFrom,omitempty"`
+	CreatedTimeTo   int64  `json:"createdTimeTo,omitempty" query:"createdTimeTo,omitempty"`
+	CreatedTimeFrom int64  `json:"createdTimeFrom,omitempty" query:"createdTimeFrom,omitempty"`
+	HasTasks        bool   `json:"hasTasks,omitempty" query:"hasTasks,omitempty"`
+	Limit           int64  `json:"limit,omitempty" query:"limit,omitempty"`
 }
 
 type RoutePlanAddTasksParams struct {
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/onfleet/gonfleet"
@@ -24,9 +25,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/list-administrators
-func (c *Client) List() ([]onfleet.Admin, error) {
+func (c *Client) List(ctx context.Context) ([]onfleet.Admin, error) {
 	admins := []onfleet.Admin{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -40,9 +42,10 @@ func (c *Client) List() ([]onfleet.Admin, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/querying-by-metadata
-func (c *Client) ListWithMetadataQuery(metadata []onfleet.Metadata) ([]onfleet.Admin, error) {
+func (c *Client) ListWithMetadataQuery(ctx context.Context, metadata []onfleet.Metadata) ([]onfleet.Admin, error) {
 	admins := []onfleet.Admin{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -56,9 +59,10 @@ func (c *Client) ListWithMetadataQuery(metadata []onfleet.Metadata) ([]onfleet.A
 }
 
 // Reference https://docs.onfleet.com/reference/create-administrator
-func (c *Client) Create(params onfleet.AdminCreateParams) (onfleet.Admin, error) {
+func (c *Client) Create(ctx context.Context, params onfleet.AdminCreateParams) (onfleet.Admin, error) {
 	admin := onfleet.Admin{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -72,9 +76,10 @@ func (c *Client) Create(params onfleet.AdminCreateParams) (onfleet.Admin, error)
 }
 
 // Reference https://docs.onfleet.com/reference/update-administrator
-func (c *Client) Update(adminId string, params onfleet.AdminUpdateParams) (onfleet.Admin, error) {
+func (c *Client) Update(ctx context.Context, adminId string, params onfleet.AdminUpdateParams) (onfleet.Admin, error) {
 	admin := onfleet.Admin{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -88,8 +93,9 @@ func (c *Client) Update(adminId string, params onfleet.AdminUpdateParams) (onfle
 }
 
 // Reference https://docs.onfleet.com/reference/delete-administrator
-func (c *Client) Delete(adminId string) error {
+func (c *Client) Delete(ctx context.Context, adminId string) error {
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodDelete,
@@ -104,14 +110,18 @@ func (c *Client) Delete(adminId string) error {
 
 // Reference https://docs.onfleet.com/reference/metadata
 // MetadataSet atomically adds or updates metadata fields without affecting other metadata
-func (c *Client) MetadataSet(adminId string, metadata ...onfleet.Metadata) (onfleet.Admin, error) {
+func (c *Client) MetadataSet(ctx context.Context, adminId string, metadata ...onfleet.Metadata) (onfleet.Admin, error) {
 	admin := onfleet.Admin{}
+	if err := onfleet.ValidateMetadata(metadata); err != nil {
+		return admin, err
+	}
 	body := map[string]any{
 		"metadata": map[string]any{
 			"$set": metadata,
 		},
 	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -126,7 +136,7 @@ func (c *Client) MetadataSet(adminId string, metadata ...onfleet.Metadata) (onfl
 
 // Reference https://docs.onfleet.com/reference/metadata
 // MetadataPop atomically removes metadata fields without affecting other metadata
-func (c *Client) MetadataPop(adminId string, names ...string) (onfleet.Admin, error) {
+func (c *Client) MetadataPop(ctx context.Context, adminId string, names ...string) (onfleet.Admin, error) {
 	admin := onfleet.Admin{}
 
 	popArray := make([]map[string]string, len(names))
@@ -140,6 +150,7 @@ func (c *Client) MetadataPop(adminId string, names ...string) (onfleet.Admin, er
 		},
 	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,7 +24,7 @@ func TestClient_List(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/admins", mockClient.MockCaller)
 
-	admins, err := client.List()
+	admins, err := client.List(context.Background())
 
 	assert.NoError(t, err)
 	assert.Len(t, admins, 1)
@@ -62,7 +63,7 @@ func TestClient_Create(t *testing.T) {
 		},
 	}
 
-	admin, err := client.Create(params)
+	admin, err := client.Create(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedAdmin.ID, admin.ID)
@@ -98,7 +99,7 @@ func TestClient_Update(t *testing.T) {
 		},
 	}
 
-	admin, err := client.Update("admin_123", params)
+	admin, err := client.Update(context.Background(), "admin_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedAdmin.ID, admin.ID)
@@ -119,7 +120,7 @@ func TestClient_Delete(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/admins", mockClient.MockCaller)
 
-	err := client.Delete("admin_123")
+	err := client.Delete(context.Background(), "admin_123")
 
 	assert.NoError(t, err)
 	mockClient.AssertRequestMade("DELETE", "/admins/admin_123")
@@ -148,7 +149,7 @@ func TestClient_ListWithMetadataQuery(t *testing.T) {
 		},
 	}
 
-	admins, err := client.ListWithMetadataQuery(metadata)
+	admins, err := client.ListWithMetadataQuery(context.Background(), metadata)
 
 	assert.NoError(t, err)
 	assert.Len(t, admins, 1)
@@ -203,7 +204,7 @@ func TestClient_AdminTypes(t *testing.T) {
 				IsReadOnly: tt.isReadOnly,
 			}
 
-			admin, err := client.Create(params)
+			admin, err := client.Create(context.Background(), params)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.adminType, admin.Type)
@@ -268,7 +269,7 @@ func TestClient_AdminPermissions(t *testing.T) {
 
 			client := Plug("test_api_key", nil, "https://api.example.com/admins", mockClient.MockCaller)
 
-			admins, err := client.List()
+			admins, err := client.List(context.Background())
 
 			assert.NoError(t, err)
 			assert.Len(t, admins, 1)
@@ -295,7 +296,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/admins",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.Create(onfleet.AdminCreateParams{
+				_, err := client.Create(context.Background(), onfleet.AdminCreateParams{
 					Email: "invalid-email",
 					Name:  "Test Admin",
 				})
@@ -308,7 +309,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/admins",
 			statusCode: 409,
 			operation: func(client *Client) error {
-				_, err := client.Create(onfleet.AdminCreateParams{
+				_, err := client.Create(context.Background(), onfleet.AdminCreateParams{
 					Email: "existing@example.com",
 					Name:  "Test Admin",
 				})
@@ -321,7 +322,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/admins/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Update("nonexistent", onfleet.AdminUpdateParams{
+				_, err := client.Update(context.Background(), "nonexistent", onfleet.AdminUpdateParams{
 					Name: "Updated Name",
 				})
 				return err
@@ -333,7 +334,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/admins/owner_123",
 			statusCode: 403,
 			operation: func(client *Client) error {
-				return client.Delete("owner_123")
+				return client.Delete(context.Background(), "owner_123")
 			},
 		},
 		{
@@ -342,7 +343,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/admins",
 			statusCode: 401,
 			operation: func(client *Client) error {
-				_, err := client.List()
+				_, err := client.List(context.Background())
 				return err
 			},
 		},
@@ -352,7 +353,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/admins/metadata",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.ListWithMetadataQuery([]onfleet.Metadata{
+				_, err := client.ListWithMetadataQuery(context.Background(), []onfleet.Metadata{
 					{
 						Name:  "invalid_field",
 						Type:  "unknown",
@@ -410,7 +411,7 @@ func TestClient_MetadataSet(t *testing.T) {
 		},
 	}
 
-	admin, err := client.MetadataSet("admin_123", metadata...)
+	admin, err := client.MetadataSet(context.Background(), "admin_123", metadata...)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedAdmin.ID, admin.ID)
@@ -423,6 +424,15 @@ func TestClient_MetadataSet(t *testing.T) {
 	mockClient.AssertRequestMade("PUT", "/admins/admin_123")
 }
 
+func TestClient_MetadataSet_ClientValidation(t *testing.T) {
+	// a nil caller panics if a request is sent
+	client := Plug("test_api_key", nil, "https://api.example.com/admins", nil)
+
+	_, err := client.MetadataSet(context.Background(), "admin_123", onfleet.Metadata{Name: "count"})
+
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+}
+
 func TestClient_MetadataSet_Atomicity(t *testing.T) {
 	mockClient := testingutil.SetupTest(t)
 	defer testingutil.CleanupTest(t, mockClient)
@@ -457,7 +467,7 @@ func TestClient_MetadataSet_Atomicity(t *testing.T) {
 		},
 	}
 
-	admin, err := client.MetadataSet("admin_123", metadata...)
+	admin, err := client.MetadataSet(context.Background(), "admin_123", metadata...)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedAdmin.ID, admin.ID)
@@ -496,7 +506,7 @@ func TestClient_MetadataPop(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/admins", mockClient.MockCaller)
 
-	admin, err := client.MetadataPop("admin_123", "temp_access")
+	admin, err := client.MetadataPop(context.Background(), "admin_123", "temp_access")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedAdmin.ID, admin.ID)
@@ -530,7 +540,7 @@ func TestClient_MetadataPop_Atomicity(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/admins", mockClient.MockCaller)
 
-	admin, err := client.MetadataPop("admin_123", "old_field")
+	admin, err := client.MetadataPop(context.Background(), "admin_123", "old_field")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedAdmin.ID, admin.ID)
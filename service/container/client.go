@@ -1,6 +1,7 @@
 package container
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/onfleet/gonfleet"
@@ -24,9 +25,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/get-container
-func (c *Client) Get(id string, key onfleet.ContainerQueryKey) (onfleet.Container, error) {
+func (c *Client) Get(ctx context.Context, id string, key onfleet.ContainerQueryKey) (onfleet.Container, error) {
 	container := onfleet.Container{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -42,9 +44,10 @@ func (c *Client) Get(id string, key onfleet.ContainerQueryKey) (onfleet.Containe
 // Reference https://docs.onfleet.com/reference/insert-tasks-at-index-or-append
 //
 // Reference https://docs.onfleet.com/reference/update-tasks
-func (c *Client) InsertTasks(id string, key onfleet.ContainerQueryKey, params onfleet.ContainerTaskInsertParams) (onfleet.Container, error) {
+func (c *Client) InsertTasks(ctx context.Context, id string, key onfleet.ContainerQueryKey, params onfleet.ContainerTaskInsertParams) (onfleet.Container, error) {
 	container := onfleet.Container{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -1,6 +1,7 @@
 package container
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ func TestClient_GetWorkerContainer(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/containers", mockClient.MockCaller)
 
-	container, err := client.Get("worker_456", onfleet.ContainerQueryKeyWorkers)
+	container, err := client.Get(context.Background(), "worker_456", onfleet.ContainerQueryKeyWorkers)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedContainer.ID, container.ID)
@@ -48,7 +49,7 @@ func TestClient_GetTeamContainer(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/containers", mockClient.MockCaller)
 
-	container, err := client.Get("team_123", onfleet.ContainerQueryKeyTeams)
+	container, err := client.Get(context.Background(), "team_123", onfleet.ContainerQueryKeyTeams)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedContainer.ID, container.ID)
@@ -74,7 +75,7 @@ func TestClient_GetOrganizationContainer(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/containers", mockClient.MockCaller)
 
-	container, err := client.Get("org_789", onfleet.ContainerQueryKeyOrganizations)
+	container, err := client.Get(context.Background(), "org_789", onfleet.ContainerQueryKeyOrganizations)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedContainer.ID, container.ID)
@@ -112,7 +113,7 @@ func TestClient_InsertTasksAtIndex(t *testing.T) {
 		ConsiderDependencies: false,
 	}
 
-	container, err := client.InsertTasks("worker_456", onfleet.ContainerQueryKeyWorkers, params)
+	container, err := client.InsertTasks(context.Background(), "worker_456", onfleet.ContainerQueryKeyWorkers, params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedContainer.ID, container.ID)
@@ -146,7 +147,7 @@ func TestClient_InsertTasksWithDependencies(t *testing.T) {
 		ConsiderDependencies: true,
 	}
 
-	container, err := client.InsertTasks("worker_456", onfleet.ContainerQueryKeyWorkers, params)
+	container, err := client.InsertTasks(context.Background(), "worker_456", onfleet.ContainerQueryKeyWorkers, params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedContainer.ID, container.ID)
@@ -209,7 +210,7 @@ func TestClient_ContainerTypes(t *testing.T) {
 
 			client := Plug("test_api_key", nil, "https://api.example.com/containers", mockClient.MockCaller)
 
-			container, err := client.Get(tt.entityID, tt.queryKey)
+			container, err := client.Get(context.Background(), tt.entityID, tt.queryKey)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.containerType, container.Type)
@@ -294,7 +295,7 @@ func TestClient_TaskOperations(t *testing.T) {
 
 			client := Plug("test_api_key", nil, "https://api.example.com/containers", mockClient.MockCaller)
 
-			container, err := client.InsertTasks("worker_456", onfleet.ContainerQueryKeyWorkers, tt.params)
+			container, err := client.InsertTasks(context.Background(), "worker_456", onfleet.ContainerQueryKeyWorkers, tt.params)
 
 			assert.NoError(t, err)
 			assert.Len(t, container.Tasks, tt.expectedTasksLength)
@@ -318,7 +319,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/containers/workers/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Get("nonexistent", onfleet.ContainerQueryKeyWorkers)
+				_, err := client.Get(context.Background(), "nonexistent", onfleet.ContainerQueryKeyWorkers)
 				return err
 			},
 		},
@@ -328,7 +329,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/containers/teams/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Get("nonexistent", onfleet.ContainerQueryKeyTeams)
+				_, err := client.Get(context.Background(), "nonexistent", onfleet.ContainerQueryKeyTeams)
 				return err
 			},
 		},
@@ -338,7 +339,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/containers/workers/inactive_worker",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.InsertTasks("inactive_worker", onfleet.ContainerQueryKeyWorkers, onfleet.ContainerTaskInsertParams{
+				_, err := client.InsertTasks(context.Background(), "inactive_worker", onfleet.ContainerQueryKeyWorkers, onfleet.ContainerTaskInsertParams{
 					Tasks: []any{
 						map[string]interface{}{"id": "task_123"},
 					},
@@ -352,7 +353,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/containers/workers/worker_456",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.InsertTasks("worker_456", onfleet.ContainerQueryKeyWorkers, onfleet.ContainerTaskInsertParams{
+				_, err := client.InsertTasks(context.Background(), "worker_456", onfleet.ContainerQueryKeyWorkers, onfleet.ContainerTaskInsertParams{
 					Tasks: []any{
 						map[string]interface{}{"id": "invalid_task"},
 					},
@@ -366,7 +367,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/containers/workers/worker_456",
 			statusCode: 401,
 			operation: func(client *Client) error {
-				_, err := client.Get("worker_456", onfleet.ContainerQueryKeyWorkers)
+				_, err := client.Get(context.Background(), "worker_456", onfleet.ContainerQueryKeyWorkers)
 				return err
 			},
 		},
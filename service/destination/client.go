@@ -1,6 +1,7 @@
 package destination
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/onfleet/gonfleet"
@@ -24,9 +25,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/get-single-destination
-func (c *Client) Get(destinationId string) (onfleet.Destination, error) {
+func (c *Client) Get(ctx context.Context, destinationId string) (onfleet.Destination, error) {
 	destination := onfleet.Destination{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -40,9 +42,10 @@ func (c *Client) Get(destinationId string) (onfleet.Destination, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/create-destination
-func (c *Client) Create(params onfleet.DestinationCreateParams) (onfleet.Destination, error) {
+func (c *Client) Create(ctx context.Context, params onfleet.DestinationCreateParams) (onfleet.Destination, error) {
 	destination := onfleet.Destination{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -56,9 +59,10 @@ func (c *Client) Create(params onfleet.DestinationCreateParams) (onfleet.Destina
 }
 
 // Reference https://docs.onfleet.com/reference/querying-by-metadata
-func (c *Client) ListWithMetadataQuery(metadata []onfleet.Metadata) ([]onfleet.Destination, error) {
+func (c *Client) ListWithMetadataQuery(ctx context.Context, metadata []onfleet.Metadata) ([]onfleet.Destination, error) {
 	destinations := []onfleet.Destination{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -73,14 +77,18 @@ func (c *Client) ListWithMetadataQuery(metadata []onfleet.Metadata) ([]onfleet.D
 
 // Reference https://docs.onfleet.com/reference/metadata
 // MetadataSet atomically adds or updates metadata fields without affecting other metadata
-func (c *Client) MetadataSet(destinationId string, metadata ...onfleet.Metadata) (onfleet.Destination, error) {
+func (c *Client) MetadataSet(ctx context.Context, destinationId string, metadata ...onfleet.Metadata) (onfleet.Destination, error) {
 	destination := onfleet.Destination{}
+	if err := onfleet.ValidateMetadata(metadata); err != nil {
+		return destination, err
+	}
 	body := map[string]any{
 		"metadata": map[string]any{
 			"$set": metadata,
 		},
 	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -95,7 +103,7 @@ func (c *Client) MetadataSet(destinationId string, metadata ...onfleet.Metadata)
 
 // Reference https://docs.onfleet.com/reference/metadata
 // MetadataPop atomically removes metadata fields without affecting other metadata
-func (c *Client) MetadataPop(destinationId string, names ...string) (onfleet.Destination, error) {
+func (c *Client) MetadataPop(ctx context.Context, destinationId string, names ...string) (onfleet.Destination, error) {
 	destination := onfleet.Destination{}
 
 	popArray := make([]map[string]string, len(names))
@@ -109,6 +117,7 @@ func (c *Client) MetadataPop(destinationId string, names ...string) (onfleet.Des
 		},
 	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
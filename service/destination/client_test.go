@@ -1,6 +1,7 @@
 package destination
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ func TestClient_Get(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/destinations", mockClient.MockCaller)
 
-	destination, err := client.Get("destination_123")
+	destination, err := client.Get(context.Background(), "destination_123")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedDestination.ID, destination.ID)
@@ -54,7 +55,7 @@ func TestClient_Create(t *testing.T) {
 		Notes: "Test destination",
 	}
 
-	destination, err := client.Create(params)
+	destination, err := client.Create(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedDestination.ID, destination.ID)
@@ -85,7 +86,7 @@ func TestClient_ListWithMetadataQuery(t *testing.T) {
 		},
 	}
 
-	destinations, err := client.ListWithMetadataQuery(metadata)
+	destinations, err := client.ListWithMetadataQuery(context.Background(), metadata)
 
 	assert.NoError(t, err)
 	assert.Len(t, destinations, 1)
@@ -120,9 +121,9 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			var err error
 			switch tt.method {
 			case "GET":
-				_, err = client.Get("nonexistent")
+				_, err = client.Get(context.Background(), "nonexistent")
 			case "POST":
-				_, err = client.Create(onfleet.DestinationCreateParams{})
+				_, err = client.Create(context.Background(), onfleet.DestinationCreateParams{})
 			}
 
 			assert.Error(t, err)
@@ -158,7 +159,7 @@ func TestClient_MetadataSet(t *testing.T) {
 		},
 	}
 
-	destination, err := client.MetadataSet("destination_123", metadata...)
+	destination, err := client.MetadataSet(context.Background(), "destination_123", metadata...)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedDestination.ID, destination.ID)
@@ -171,6 +172,15 @@ func TestClient_MetadataSet(t *testing.T) {
 	mockClient.AssertRequestMade("PUT", "/destinations/destination_123")
 }
 
+func TestClient_MetadataSet_ClientValidation(t *testing.T) {
+	// a nil caller panics if a request is sent
+	client := Plug("test_api_key", nil, "https://api.example.com/destinations", nil)
+
+	_, err := client.MetadataSet(context.Background(), "destination_123", onfleet.Metadata{Name: "count"})
+
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+}
+
 func TestClient_MetadataSet_Atomicity(t *testing.T) {
 	mockClient := testingutil.SetupTest(t)
 	defer testingutil.CleanupTest(t, mockClient)
@@ -205,7 +215,7 @@ func TestClient_MetadataSet_Atomicity(t *testing.T) {
 		},
 	}
 
-	destination, err := client.MetadataSet("destination_123", metadata...)
+	destination, err := client.MetadataSet(context.Background(), "destination_123", metadata...)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedDestination.ID, destination.ID)
@@ -244,7 +254,7 @@ func TestClient_MetadataPop(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/destinations", mockClient.MockCaller)
 
-	destination, err := client.MetadataPop("destination_123", "temp_flag")
+	destination, err := client.MetadataPop(context.Background(), "destination_123", "temp_flag")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedDestination.ID, destination.ID)
@@ -278,7 +288,7 @@ func TestClient_MetadataPop_Atomicity(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/destinations", mockClient.MockCaller)
 
-	destination, err := client.MetadataPop("destination_123", "old_field")
+	destination, err := client.MetadataPop(context.Background(), "destination_123", "old_field")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedDestination.ID, destination.ID)
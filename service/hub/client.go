@@ -1,6 +1,7 @@
 package hub
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/onfleet/gonfleet"
@@ -24,9 +25,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/list-hubs
-func (c *Client) List() ([]onfleet.Hub, error) {
+func (c *Client) List(ctx context.Context) ([]onfleet.Hub, error) {
 	hubs := []onfleet.Hub{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -40,9 +42,10 @@ func (c *Client) List() ([]onfleet.Hub, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/create-hub
-func (c *Client) Create(params onfleet.HubCreateParams) (onfleet.Hub, error) {
+func (c *Client) Create(ctx context.Context, params onfleet.HubCreateParams) (onfleet.Hub, error) {
 	hub := onfleet.Hub{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -56,9 +59,10 @@ func (c *Client) Create(params onfleet.HubCreateParams) (onfleet.Hub, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/update-hub
-func (c *Client) Update(hubId string, params onfleet.HubUpdateParams) (onfleet.Hub, error) {
+func (c *Client) Update(ctx context.Context, hubId string, params onfleet.HubUpdateParams) (onfleet.Hub, error) {
 	hub := onfleet.Hub{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -1,6 +1,7 @@
 package hub
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,7 +24,7 @@ func TestClient_List(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/hubs", mockClient.MockCaller)
 
-	hubs, err := client.List()
+	hubs, err := client.List(context.Background())
 
 	assert.NoError(t, err)
 	assert.Len(t, hubs, 1)
@@ -59,7 +60,7 @@ func TestClient_Create(t *testing.T) {
 		Teams: []string{"team_789"},
 	}
 
-	hub, err := client.Create(params)
+	hub, err := client.Create(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedHub.ID, hub.ID)
@@ -94,7 +95,7 @@ func TestClient_Update(t *testing.T) {
 		Teams: []string{"team_123", "team_456", "team_789"},
 	}
 
-	hub, err := client.Update("hub_123", params)
+	hub, err := client.Update(context.Background(), "hub_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedHub.ID, hub.ID)
@@ -161,7 +162,7 @@ func TestClient_AddressValidation(t *testing.T) {
 				Address: tt.address,
 			}
 
-			hub, err := client.Create(params)
+			hub, err := client.Create(context.Background(), params)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.address.Street, hub.Address.Street)
@@ -216,7 +217,7 @@ func TestClient_TeamAssignment(t *testing.T) {
 				Teams: tt.teams,
 			}
 
-			hub, err := client.Create(params)
+			hub, err := client.Create(context.Background(), params)
 
 			assert.NoError(t, err)
 			assert.Len(t, hub.Teams, len(tt.teams))
@@ -241,7 +242,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/hubs",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.Create(onfleet.HubCreateParams{
+				_, err := client.Create(context.Background(), onfleet.HubCreateParams{
 					Name: "Invalid Hub",
 					Address: onfleet.DestinationAddress{
 						Street: "", // Invalid empty street
@@ -256,7 +257,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/hubs",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.Create(onfleet.HubCreateParams{
+				_, err := client.Create(context.Background(), onfleet.HubCreateParams{
 					Name: "", // Invalid empty name
 					Address: onfleet.DestinationAddress{
 						Street:  "123 Test Street",
@@ -273,7 +274,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/hubs/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Update("nonexistent", onfleet.HubUpdateParams{
+				_, err := client.Update(context.Background(), "nonexistent", onfleet.HubUpdateParams{
 					Name: "Updated Hub",
 					Address: onfleet.DestinationAddress{
 						Street:  "123 Test Street",
@@ -290,7 +291,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/hubs",
 			statusCode: 401,
 			operation: func(client *Client) error {
-				_, err := client.List()
+				_, err := client.List(context.Background())
 				return err
 			},
 		},
@@ -1,6 +1,7 @@
 package organization
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/onfleet/gonfleet"
@@ -26,9 +27,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, altUrl s
 }
 
 // Reference https://docs.onfleet.com/reference/get-details
-func (c *Client) Get() (onfleet.Organization, error) {
+func (c *Client) Get(ctx context.Context) (onfleet.Organization, error) {
 	organization := onfleet.Organization{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -42,9 +44,10 @@ func (c *Client) Get() (onfleet.Organization, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/get-delegatee-details
-func (c *Client) GetDelegate(orgId string) (onfleet.OrganizationDelegate, error) {
+func (c *Client) GetDelegate(ctx context.Context, orgId string) (onfleet.OrganizationDelegate, error) {
 	delegate := onfleet.OrganizationDelegate{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
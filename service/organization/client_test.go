@@ -1,6 +1,7 @@
 package organization
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ func TestClient_Get(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/organization", "https://api.example.com/organizations", mockClient.MockCaller)
 
-	org, err := client.Get()
+	org, err := client.Get(context.Background())
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedOrg.ID, org.ID)
@@ -42,7 +43,7 @@ func TestClient_Get_Error(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/organization", "https://api.example.com/organizations", mockClient.MockCaller)
 
-	org, err := client.Get()
+	org, err := client.Get(context.Background())
 
 	assert.Error(t, err)
 	assert.Equal(t, "", org.ID)
@@ -69,7 +70,7 @@ func TestClient_GetDelegate(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/organization", "https://api.example.com/organizations", mockClient.MockCaller)
 
-	delegate, err := client.GetDelegate("delegate_123")
+	delegate, err := client.GetDelegate(context.Background(), "delegate_123")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedDelegate.ID, delegate.ID)
@@ -92,7 +93,7 @@ func TestClient_GetDelegate_NotFound(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/organization", "https://api.example.com/organizations", mockClient.MockCaller)
 
-	delegate, err := client.GetDelegate("nonexistent")
+	delegate, err := client.GetDelegate(context.Background(), "nonexistent")
 
 	assert.Error(t, err)
 	assert.Equal(t, "", delegate.ID)
@@ -109,7 +110,7 @@ func TestClient_GetDelegate_Forbidden(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/organization", "https://api.example.com/organizations", mockClient.MockCaller)
 
-	delegate, err := client.GetDelegate("delegate_123")
+	delegate, err := client.GetDelegate(context.Background(), "delegate_123")
 
 	assert.Error(t, err)
 	assert.Equal(t, "", delegate.ID)
@@ -147,7 +148,7 @@ func TestClient_URLUsage(t *testing.T) {
 				})
 
 				client := Plug("test_api_key", nil, "https://api.example.com/organization", "https://api.example.com/organizations", mockClient.MockCaller)
-				_, err := client.Get()
+				_, err := client.Get(context.Background())
 				assert.NoError(t, err)
 
 			} else if tt.operation == "delegate" {
@@ -161,7 +162,7 @@ func TestClient_URLUsage(t *testing.T) {
 				})
 
 				client := Plug("test_api_key", nil, "https://api.example.com/organization", "https://api.example.com/organizations", mockClient.MockCaller)
-				_, err := client.GetDelegate("delegate_123")
+				_, err := client.GetDelegate(context.Background(), "delegate_123")
 				assert.NoError(t, err)
 			}
 
@@ -221,7 +222,7 @@ func TestClient_OrganizationConfigurations(t *testing.T) {
 
 			client := Plug("test_api_key", nil, "https://api.example.com/organization", "https://api.example.com/organizations", mockClient.MockCaller)
 
-			org, err := client.Get()
+			org, err := client.Get(context.Background())
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.orgData.ID, org.ID)
@@ -273,7 +274,7 @@ func TestClient_DelegateConfigurations(t *testing.T) {
 
 			client := Plug("test_api_key", nil, "https://api.example.com/organization", "https://api.example.com/organizations", mockClient.MockCaller)
 
-			delegate, err := client.GetDelegate(tt.delegateData.ID)
+			delegate, err := client.GetDelegate(context.Background(), tt.delegateData.ID)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.delegateData.ID, delegate.ID)
@@ -322,9 +323,9 @@ func TestClient_ErrorScenarios(t *testing.T) {
 
 			var err error
 			if tt.operation == "get" {
-				_, err = client.Get()
+				_, err = client.Get(context.Background())
 			} else {
-				_, err = client.GetDelegate("test_delegate")
+				_, err = client.GetDelegate(context.Background(), "test_delegate")
 			}
 
 			if tt.expectErr {
@@ -391,7 +392,7 @@ func TestClient_DifferentURLConfigurations(t *testing.T) {
 			client := Plug("test_api_key", nil, tt.organizationURL, tt.organizationsURL, mockClient.MockCaller)
 
 			// Test Get
-			org, err := client.Get()
+			org, err := client.Get(context.Background())
 			assert.NoError(t, err)
 			assert.Equal(t, expectedOrg.ID, org.ID)
 
@@ -403,7 +404,7 @@ func TestClient_DifferentURLConfigurations(t *testing.T) {
 			})
 
 			// Test GetDelegate
-			delegate, err := client.GetDelegate("delegate_123")
+			delegate, err := client.GetDelegate(context.Background(), "delegate_123")
 			assert.NoError(t, err)
 			assert.Equal(t, expectedDelegate.ID, delegate.ID)
 		})
@@ -1,6 +1,7 @@
 package manifest
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -25,7 +26,7 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/delivery-manifest
-func (c *Client) Generate(params *onfleet.ManifestGenerateParams, googleAPIKey string) (onfleet.DeliveryManifest, error) {
+func (c *Client) Generate(ctx context.Context, params *onfleet.ManifestGenerateParams, googleAPIKey string) (onfleet.DeliveryManifest, error) {
 	deliveryManifest := onfleet.DeliveryManifest{}
 	hubId := params.HubId
 	workerId := params.WorkerId
@@ -43,6 +44,7 @@ func (c *Client) Generate(params *onfleet.ManifestGenerateParams, googleAPIKey s
 	}
 
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -1,6 +1,7 @@
 package manifest
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,7 +26,7 @@ func TestClient_Generate(t *testing.T) {
 		WorkerId: "worker_456",
 	}
 
-	manifest, err := client.Generate(params, "")
+	manifest, err := client.Generate(context.Background(), params, "")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedManifest.DepartureTime, manifest.DepartureTime)
@@ -86,7 +87,7 @@ func TestClient_GenerateWithGoogleAPIKey(t *testing.T) {
 	}
 
 	googleAPIKey := "AIzaSyABCDEF123456789"
-	manifest, err := client.Generate(params, googleAPIKey)
+	manifest, err := client.Generate(context.Background(), params, googleAPIKey)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedManifest.DepartureTime, manifest.DepartureTime)
@@ -159,7 +160,7 @@ func TestClient_ManifestWithDifferentVehicleTypes(t *testing.T) {
 				WorkerId: "worker_456",
 			}
 
-			manifest, err := client.Generate(params, "")
+			manifest, err := client.Generate(context.Background(), params, "")
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.vehicleType, manifest.Vehicle.Type)
@@ -238,7 +239,7 @@ func TestClient_ManifestWithMultipleTasks(t *testing.T) {
 		WorkerId: "worker_456",
 	}
 
-	manifest, err := client.Generate(params, "")
+	manifest, err := client.Generate(context.Background(), params, "")
 
 	assert.NoError(t, err)
 	assert.Len(t, manifest.Tasks, 3)
@@ -295,7 +296,7 @@ func TestClient_ManifestDriverInformation(t *testing.T) {
 				WorkerId: "worker_456",
 			}
 
-			manifest, err := client.Generate(params, "")
+			manifest, err := client.Generate(context.Background(), params, "")
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.driverName, manifest.Driver.Name)
@@ -318,7 +319,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/providers/manifest",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Generate(&onfleet.ManifestGenerateParams{
+				_, err := client.Generate(context.Background(), &onfleet.ManifestGenerateParams{
 					HubId:    "hub_123",
 					WorkerId: "nonexistent_worker",
 				}, "")
@@ -331,7 +332,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/providers/manifest",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Generate(&onfleet.ManifestGenerateParams{
+				_, err := client.Generate(context.Background(), &onfleet.ManifestGenerateParams{
 					HubId:    "nonexistent_hub",
 					WorkerId: "worker_456",
 				}, "")
@@ -344,7 +345,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/providers/manifest",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.Generate(&onfleet.ManifestGenerateParams{
+				_, err := client.Generate(context.Background(), &onfleet.ManifestGenerateParams{
 					// Missing required parameters
 				}, "")
 				return err
@@ -356,7 +357,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/providers/manifest",
 			statusCode: 401,
 			operation: func(client *Client) error {
-				_, err := client.Generate(&onfleet.ManifestGenerateParams{
+				_, err := client.Generate(context.Background(), &onfleet.ManifestGenerateParams{
 					HubId:    "hub_123",
 					WorkerId: "worker_456",
 				}, "invalid_google_key")
@@ -369,7 +370,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/providers/manifest",
 			statusCode: 422,
 			operation: func(client *Client) error {
-				_, err := client.Generate(&onfleet.ManifestGenerateParams{
+				_, err := client.Generate(context.Background(), &onfleet.ManifestGenerateParams{
 					HubId:    "hub_123",
 					WorkerId: "worker_without_tasks",
 				}, "")
@@ -382,7 +383,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/providers/manifest",
 			statusCode: 403,
 			operation: func(client *Client) error {
-				_, err := client.Generate(&onfleet.ManifestGenerateParams{
+				_, err := client.Generate(context.Background(), &onfleet.ManifestGenerateParams{
 					HubId:    "hub_123",
 					WorkerId: "worker_456",
 				}, "")
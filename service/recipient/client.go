@@ -1,6 +1,7 @@
 package recipient
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/onfleet/gonfleet"
@@ -24,9 +25,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/get-single-recipient
-func (c *Client) Get(recipientId string) (onfleet.Recipient, error) {
+func (c *Client) Get(ctx context.Context, recipientId string) (onfleet.Recipient, error) {
 	recipient := onfleet.Recipient{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -40,9 +42,10 @@ func (c *Client) Get(recipientId string) (onfleet.Recipient, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/find-recipient
-func (c *Client) Find(value string, key onfleet.RecipientQueryKey) (onfleet.Recipient, error) {
+func (c *Client) Find(ctx context.Context, value string, key onfleet.RecipientQueryKey) (onfleet.Recipient, error) {
 	recipient := onfleet.Recipient{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -56,9 +59,10 @@ func (c *Client) Find(value string, key onfleet.RecipientQueryKey) (onfleet.Reci
 }
 
 // Reference https://docs.onfleet.com/reference/update-recipient
-func (c *Client) Update(recipientId string, params onfleet.RecipientUpdateParams) (onfleet.Recipient, error) {
+func (c *Client) Update(ctx context.Context, recipientId string, params onfleet.RecipientUpdateParams) (onfleet.Recipient, error) {
 	recipient := onfleet.Recipient{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -72,9 +76,10 @@ func (c *Client) Update(recipientId string, params onfleet.RecipientUpdateParams
 }
 
 // Reference https://docs.onfleet.com/reference/create-recipient
-func (c *Client) Create(params onfleet.RecipientCreateParams) (onfleet.Recipient, error) {
+func (c *Client) Create(ctx context.Context, params onfleet.RecipientCreateParams) (onfleet.Recipient, error) {
 	recipient := onfleet.Recipient{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -88,9 +93,10 @@ func (c *Client) Create(params onfleet.RecipientCreateParams) (onfleet.Recipient
 }
 
 // Reference https://docs.onfleet.com/reference/querying-by-metadata
-func (c *Client) ListWithMetadataQuery(metadata []onfleet.Metadata) ([]onfleet.Recipient, error) {
+func (c *Client) ListWithMetadataQuery(ctx context.Context, metadata []onfleet.Metadata) ([]onfleet.Recipient, error) {
 	recipients := []onfleet.Recipient{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -105,14 +111,18 @@ func (c *Client) ListWithMetadataQuery(metadata []onfleet.Metadata) ([]onfleet.R
 
 // Reference https://docs.onfleet.com/reference/metadata
 // MetadataSet atomically adds or updates metadata fields without affecting other metadata
-func (c *Client) MetadataSet(recipientId string, metadata ...onfleet.Metadata) (onfleet.Recipient, error) {
+func (c *Client) MetadataSet(ctx context.Context, recipientId string, metadata ...onfleet.Metadata) (onfleet.Recipient, error) {
 	recipient := onfleet.Recipient{}
+	if err := onfleet.ValidateMetadata(metadata); err != nil {
+		return recipient, err
+	}
 	body := map[string]any{
 		"metadata": map[string]any{
 			"$set": metadata,
 		},
 	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -127,7 +137,7 @@ func (c *Client) MetadataSet(recipientId string, metadata ...onfleet.Metadata) (
 
 // Reference https://docs.onfleet.com/reference/metadata
 // MetadataPop atomically removes metadata fields without affecting other metadata
-func (c *Client) MetadataPop(recipientId string, names ...string) (onfleet.Recipient, error) {
+func (c *Client) MetadataPop(ctx context.Context, recipientId string, names ...string) (onfleet.Recipient, error) {
 	recipient := onfleet.Recipient{}
 
 	popArray := make([]map[string]string, len(names))
@@ -141,6 +151,7 @@ func (c *Client) MetadataPop(recipientId string, names ...string) (onfleet.Recip
 		},
 	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -1,6 +1,7 @@
 package recipient
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ func TestClient_Get(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/recipients", mockClient.MockCaller)
 
-	recipient, err := client.Get("recipient_123")
+	recipient, err := client.Get(context.Background(), "recipient_123")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRecipient.ID, recipient.ID)
@@ -43,7 +44,7 @@ func TestClient_FindByName(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/recipients", mockClient.MockCaller)
 
-	recipient, err := client.Find("Jane Smith", onfleet.RecipientQueryKeyName)
+	recipient, err := client.Find(context.Background(), "Jane Smith", onfleet.RecipientQueryKeyName)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRecipient.ID, recipient.ID)
@@ -64,7 +65,7 @@ func TestClient_FindByPhone(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/recipients", mockClient.MockCaller)
 
-	recipient, err := client.Find("+15559876543", onfleet.RecipientQueryKeyPhone)
+	recipient, err := client.Find(context.Background(), "+15559876543", onfleet.RecipientQueryKeyPhone)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRecipient.ID, recipient.ID)
@@ -91,7 +92,7 @@ func TestClient_Create(t *testing.T) {
 		Notes: "Preferred contact time: evenings",
 	}
 
-	recipient, err := client.Create(params)
+	recipient, err := client.Create(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRecipient.ID, recipient.ID)
@@ -117,7 +118,7 @@ func TestClient_Update(t *testing.T) {
 		Notes: "Updated notes",
 	}
 
-	recipient, err := client.Update("recipient_123", params)
+	recipient, err := client.Update(context.Background(), "recipient_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRecipient.ID, recipient.ID)
@@ -149,7 +150,7 @@ func TestClient_ListWithMetadataQuery(t *testing.T) {
 		},
 	}
 
-	recipients, err := client.ListWithMetadataQuery(metadata)
+	recipients, err := client.ListWithMetadataQuery(context.Background(), metadata)
 
 	assert.NoError(t, err)
 	assert.Len(t, recipients, 1)
@@ -172,7 +173,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/recipients/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Get("nonexistent")
+				_, err := client.Get(context.Background(), "nonexistent")
 				return err
 			},
 		},
@@ -182,7 +183,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/recipients/name/Unknown",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Find("Unknown", onfleet.RecipientQueryKeyName)
+				_, err := client.Find(context.Background(), "Unknown", onfleet.RecipientQueryKeyName)
 				return err
 			},
 		},
@@ -192,7 +193,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/recipients/phone/+15550000000",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Find("+15550000000", onfleet.RecipientQueryKeyPhone)
+				_, err := client.Find(context.Background(), "+15550000000", onfleet.RecipientQueryKeyPhone)
 				return err
 			},
 		},
@@ -202,7 +203,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/recipients",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.Create(onfleet.RecipientCreateParams{})
+				_, err := client.Create(context.Background(), onfleet.RecipientCreateParams{})
 				return err
 			},
 		},
@@ -212,7 +213,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/recipients/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Update("nonexistent", onfleet.RecipientUpdateParams{})
+				_, err := client.Update(context.Background(), "nonexistent", onfleet.RecipientUpdateParams{})
 				return err
 			},
 		},
@@ -269,7 +270,7 @@ func TestClient_PhoneNumberEncoding(t *testing.T) {
 
 			client := Plug("test_api_key", nil, "https://api.example.com/recipients", mockClient.MockCaller)
 
-			recipient, err := client.Find(tt.phoneNumber, onfleet.RecipientQueryKeyPhone)
+			recipient, err := client.Find(context.Background(), tt.phoneNumber, onfleet.RecipientQueryKeyPhone)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.phoneNumber, recipient.Phone)
@@ -305,7 +306,7 @@ func TestClient_MetadataSet(t *testing.T) {
 		},
 	}
 
-	recipient, err := client.MetadataSet("recipient_123", metadata...)
+	recipient, err := client.MetadataSet(context.Background(), "recipient_123", metadata...)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRecipient.ID, recipient.ID)
@@ -318,6 +319,15 @@ func TestClient_MetadataSet(t *testing.T) {
 	mockClient.AssertRequestMade("PUT", "/recipients/recipient_123")
 }
 
+func TestClient_MetadataSet_ClientValidation(t *testing.T) {
+	// a nil caller panics if a request is sent
+	client := Plug("test_api_key", nil, "https://api.example.com/recipients", nil)
+
+	_, err := client.MetadataSet(context.Background(), "recipient_123", onfleet.Metadata{Name: "count"})
+
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+}
+
 func TestClient_MetadataSet_Atomicity(t *testing.T) {
 	mockClient := testingutil.SetupTest(t)
 	defer testingutil.CleanupTest(t, mockClient)
@@ -352,7 +362,7 @@ func TestClient_MetadataSet_Atomicity(t *testing.T) {
 		},
 	}
 
-	recipient, err := client.MetadataSet("recipient_123", metadata...)
+	recipient, err := client.MetadataSet(context.Background(), "recipient_123", metadata...)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRecipient.ID, recipient.ID)
@@ -391,7 +401,7 @@ func TestClient_MetadataPop(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/recipients", mockClient.MockCaller)
 
-	recipient, err := client.MetadataPop("recipient_123", "temp_note")
+	recipient, err := client.MetadataPop(context.Background(), "recipient_123", "temp_note")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRecipient.ID, recipient.ID)
@@ -425,7 +435,7 @@ func TestClient_MetadataPop_Atomicity(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/recipients", mockClient.MockCaller)
 
-	recipient, err := client.MetadataPop("recipient_123", "old_field")
+	recipient, err := client.MetadataPop(context.Background(), "recipient_123", "old_field")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRecipient.ID, recipient.ID)
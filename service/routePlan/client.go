@@ -1,6 +1,7 @@
 package routePlan
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/onfleet/gonfleet"
@@ -24,9 +25,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/post-create-route-plan
-func (c *Client) Create(params onfleet.RoutePlanParams) (onfleet.RoutePlan, error) {
+func (c *Client) Create(ctx context.Context, params onfleet.RoutePlanParams) (onfleet.RoutePlan, error) {
 	routePlan := onfleet.RoutePlan{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -40,9 +42,10 @@ func (c *Client) Create(params onfleet.RoutePlanParams) (onfleet.RoutePlan, erro
 }
 
 // Reference https://docs.onfleet.com/reference/update-route-plan
-func (c *Client) Update(routePlanId string, params onfleet.RoutePlanParams) (onfleet.RoutePlan, error) {
+func (c *Client) Update(ctx context.Context, routePlanId string, params onfleet.RoutePlanParams) (onfleet.RoutePlan, error) {
 	routePlan := onfleet.RoutePlan{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -56,9 +59,10 @@ func (c *Client) Update(routePlanId string, params onfleet.RoutePlanParams) (onf
 }
 
 // Reference https://docs.onfleet.com/reference/add-tasks-to-route-plan
-func (c *Client) AddTasks(routePlanId string, params onfleet.RoutePlanAddTasksParams) (onfleet.RoutePlan, error) {
+func (c *Client) AddTasks(ctx context.Context, routePlanId string, params onfleet.RoutePlanAddTasksParams) (onfleet.RoutePlan, error) {
 	routePlan := onfleet.RoutePlan{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -72,9 +76,10 @@ func (c *Client) AddTasks(routePlanId string, params onfleet.RoutePlanAddTasksPa
 }
 
 // Reference https://docs.onfleet.com/reference/get-routeplan-by-id
-func (c *Client) Get(routePlanId string) (onfleet.RoutePlan, error) {
+func (c *Client) Get(ctx context.Context, routePlanId string) (onfleet.RoutePlan, error) {
 	routePlan := onfleet.RoutePlan{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -88,9 +93,10 @@ func (c *Client) Get(routePlanId string) (onfleet.RoutePlan, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/get-route-plan
-func (c *Client) List(params onfleet.RoutePlanListQueryParams) ([]onfleet.RoutePlan, error) {
+func (c *Client) List(ctx context.Context, params onfleet.RoutePlanListQueryParams) ([]onfleet.RoutePlan, error) {
 	var routePlans []onfleet.RoutePlan
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -104,8 +110,9 @@ func (c *Client) List(params onfleet.RoutePlanListQueryParams) ([]onfleet.RouteP
 }
 
 // Reference https://docs.onfleet.com/reference/delete-routePlan
-func (c *Client) Delete(routePlanId string) error {
+func (c *Client) Delete(ctx context.Context, routePlanId string) error {
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodDelete,
@@ -1,6 +1,7 @@
 package routePlan
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,7 +37,7 @@ func TestClient_Create(t *testing.T) {
 		Timezone:      "America/Los_Angeles",
 	}
 
-	routePlan, err := client.Create(params)
+	routePlan, err := client.Create(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRoutePlan.Id, routePlan.Id)
@@ -59,7 +60,7 @@ func TestClient_Get(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/routePlans", mockClient.MockCaller)
 
-	routePlan, err := client.Get("routeplan_123")
+	routePlan, err := client.Get(context.Background(), "routeplan_123")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRoutePlan.Id, routePlan.Id)
@@ -92,7 +93,7 @@ func TestClient_Update(t *testing.T) {
 		StartTime:   1640995200,
 	}
 
-	routePlan, err := client.Update("routeplan_123", params)
+	routePlan, err := client.Update(context.Background(), "routeplan_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRoutePlan.Id, routePlan.Id)
@@ -120,7 +121,7 @@ func TestClient_AddTasks(t *testing.T) {
 		Tasks: []string{"task_444", "task_555"},
 	}
 
-	routePlan, err := client.AddTasks("routeplan_123", params)
+	routePlan, err := client.AddTasks(context.Background(), "routeplan_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedRoutePlan.Id, routePlan.Id)
@@ -156,7 +157,7 @@ func TestClient_List(t *testing.T) {
 		Limit:           10,
 	}
 
-	RoutePlans, err := client.List(params)
+	RoutePlans, err := client.List(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Len(t, RoutePlans, 1)
@@ -176,7 +177,7 @@ func TestClient_Delete(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/routePlans", mockClient.MockCaller)
 
-	err := client.Delete("routeplan_123")
+	err := client.Delete(context.Background(), "routeplan_123")
 
 	assert.NoError(t, err)
 	mockClient.AssertRequestMade("DELETE", "/routePlans/routeplan_123")
@@ -231,7 +232,7 @@ func TestClient_VehicleTypes(t *testing.T) {
 				Worker:      "worker_123",
 			}
 
-			routePlan, err := client.Create(params)
+			routePlan, err := client.Create(context.Background(), params)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.vehicleType, routePlan.VehicleType)
@@ -304,7 +305,7 @@ func TestClient_PositionTypes(t *testing.T) {
 				EndingHubId:   tt.endHub,
 			}
 
-			routePlan, err := client.Create(params)
+			routePlan, err := client.Create(context.Background(), params)
 
 			assert.NoError(t, err)
 			assert.Equal(t, expectedRoutePlan.Id, routePlan.Id)
@@ -352,7 +353,7 @@ func TestClient_RoutePlanStates(t *testing.T) {
 
 			client := Plug("test_api_key", nil, "https://api.example.com/routePlans", mockClient.MockCaller)
 
-			routePlan, err := client.Get("routeplan_123")
+			routePlan, err := client.Get(context.Background(), "routeplan_123")
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.state, routePlan.State)
@@ -374,7 +375,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/routePlans",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.Create(onfleet.RoutePlanParams{
+				_, err := client.Create(context.Background(), onfleet.RoutePlanParams{
 					Name:      "Invalid Route",
 					StartTime: 0, // Invalid start time
 				})
@@ -387,7 +388,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/routePlans",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.Create(onfleet.RoutePlanParams{
+				_, err := client.Create(context.Background(), onfleet.RoutePlanParams{
 					Name:      "Missing Assignment Route",
 					StartTime: 1640995200,
 					// Missing both worker and team
@@ -401,7 +402,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/routePlans/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Get("nonexistent")
+				_, err := client.Get(context.Background(), "nonexistent")
 				return err
 			},
 		},
@@ -411,7 +412,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/routePlans/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Update("nonexistent", onfleet.RoutePlanParams{
+				_, err := client.Update(context.Background(), "nonexistent", onfleet.RoutePlanParams{
 					Name:      "Updated Route",
 					StartTime: 1640995200,
 				})
@@ -424,7 +425,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/routePlans/completed_123",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.AddTasks("completed_123", onfleet.RoutePlanAddTasksParams{
+				_, err := client.AddTasks(context.Background(), "completed_123", onfleet.RoutePlanAddTasksParams{
 					Tasks: []string{"task_123"},
 				})
 				return err
@@ -436,7 +437,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/routePlans/active_123",
 			statusCode: 409,
 			operation: func(client *Client) error {
-				return client.Delete("active_123")
+				return client.Delete(context.Background(), "active_123")
 			},
 		},
 		{
@@ -445,7 +446,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/routePlans/all",
 			statusCode: 401,
 			operation: func(client *Client) error {
-				_, err := client.List(onfleet.RoutePlanListQueryParams{})
+				_, err := client.List(context.Background(), onfleet.RoutePlanListQueryParams{})
 				return err
 			},
 		},
@@ -0,0 +1,250 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/onfleet/gonfleet"
+)
+
+// MaxBatchCreateSize is the most tasks Onfleet accepts in a single batch
+// creation request.
+const MaxBatchCreateSize = 100
+
+// BatchCreateOptions tunes how BatchCreateAll splits and sends tasks.
+type BatchCreateOptions struct {
+	// ChunkSize is the number of tasks sent per request. Zero, or a value
+	// above MaxBatchCreateSize, uses MaxBatchCreateSize.
+	ChunkSize int
+	// Concurrency is the number of requests in flight at once. Zero or one
+	// sends chunks one after another. Every request still goes through the
+	// client's rate limiter.
+	Concurrency int
+}
+
+type batchChunkResult struct {
+	response onfleet.TaskBatchCreateResponse
+	err      error
+}
+
+// BatchCreateAll creates any number of tasks by splitting params into
+// chunks of at most opts.ChunkSize tasks, each sent with BatchCreate. The
+// Tasks and Errors of every chunk are merged in input order and each error
+// keeps the Index of its task in params.Tasks. opts may be nil.
+//
+// params is validated as a whole before anything is sent, see
+// WithoutValidation. A chunk that fails as a whole, e.g. on a network
+// error, contributes one error per task of the chunk and its error is
+// returned alongside the merged response, so tasks created by other chunks
+// are never lost.
+func (c *Client) BatchCreateAll(ctx context.Context, params onfleet.TaskBatchCreateParams, opts *BatchCreateOptions) (onfleet.TaskBatchCreateResponse, error) {
+	options := BatchCreateOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.ChunkSize <= 0 || options.ChunkSize > MaxBatchCreateSize {
+		options.ChunkSize = MaxBatchCreateSize
+	}
+	if options.Concurrency < 1 {
+		options.Concurrency = 1
+	}
+
+	// validated once up front so that problems are reported by input index
+	if err := validate(ctx, params); err != nil {
+		return onfleet.TaskBatchCreateResponse{}, err
+	}
+	ctx = WithoutValidation(ctx)
+
+	var chunks [][]onfleet.TaskParams
+	for start := 0; start < len(params.Tasks); start += options.ChunkSize {
+		end := min(start+options.ChunkSize, len(params.Tasks))
+		chunks = append(chunks, params.Tasks[start:end])
+	}
+
+	results := make([]batchChunkResult, len(chunks))
+	sem := make(chan struct{}, options.Concurrency)
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, chunk []onfleet.TaskParams) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			response, err := c.BatchCreate(ctx, onfleet.TaskBatchCreateParams{Tasks: chunk})
+			results[i] = batchChunkResult{response: response, err: err}
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	merged := onfleet.TaskBatchCreateResponse{
+		Tasks:  []onfleet.Task{},
+		Errors: []onfleet.TaskBatchCreateError{},
+	}
+	var errs []error
+	for i, result := range results {
+		offset := i * options.ChunkSize
+		if result.err != nil {
+			errs = append(errs, fmt.Errorf("task: batch of tasks %d to %d: %w", offset, offset+len(chunks[i])-1, result.err))
+			message := chunkErrorMessage(result.err)
+			for j, task := range chunks[i] {
+				merged.Errors = append(merged.Errors, onfleet.TaskBatchCreateError{
+					Error: message,
+					Task:  task,
+					Index: offset + j,
+				})
+			}
+			continue
+		}
+		merged.Tasks = append(merged.Tasks, result.response.Tasks...)
+		for _, batchErr := range result.response.Errors {
+			if batchErr.Index >= 0 {
+				batchErr.Index += offset
+			}
+			merged.Errors = append(merged.Errors, batchErr)
+		}
+	}
+	return merged, errors.Join(errs...)
+}
+
+// chunkErrorMessage describes err, the failure of a whole batch request, as
+// the error of each of its tasks.
+func chunkErrorMessage(err error) onfleet.RequestErrorMessage {
+	var reqErr onfleet.RequestError
+	if errors.As(err, &reqErr) {
+		message := reqErr.Message
+		if message.StatusCode == 0 {
+			message.StatusCode = reqErr.HTTPStatus
+		}
+		return message
+	}
+	return onfleet.RequestErrorMessage{Message: err.Error()}
+}
+
+// indexBatchErrors sets the Index of every error to the position of the
+// task it echoes in tasks. Each task is matched at most once, so identical
+// tasks are matched in order.
+func indexBatchErrors(batchErrors []onfleet.TaskBatchCreateError, tasks []onfleet.TaskParams) {
+	if len(batchErrors) == 0 {
+		return
+	}
+	keys := make([]string, len(tasks))
+	for i, task := range tasks {
+		keys[i] = canonicalTaskParams(task)
+	}
+	matched := make([]bool, len(tasks))
+	for i := range batchErrors {
+		batchErrors[i].Index = -1
+		key := canonicalTaskParams(batchErrors[i].Task)
+		if key == "" {
+			continue
+		}
+		for j := range tasks {
+			if !matched[j] && keys[j] == key {
+				matched[j] = true
+				batchErrors[i].Index = j
+				break
+			}
+		}
+	}
+}
+
+// canonicalTaskParams encodes task so that equal tasks compare equal
+// whether their any typed fields, such as metadata values, hold structs or
+// decoded maps.
+func canonicalTaskParams(task onfleet.TaskParams) string {
+	b, err := json.Marshal(task)
+	if err != nil {
+		return ""
+	}
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return ""
+	}
+	b, _ = json.Marshal(v)
+	return string(b)
+}
+
+// ErrBatchJobErrored is returned by WaitForBatchJob when the job ends in
+// the errored state.
+var ErrBatchJobErrored = errors.New("task: batch job errored")
+
+// ErrBatchJobUnknownStatus is returned by WaitForBatchJob when a poll
+// reports a status other than pending, processing, completed or errored,
+// which could otherwise be polled forever.
+var ErrBatchJobUnknownStatus = errors.New("task: batch job has an unknown status")
+
+const (
+	defaultBatchJobPollInitialInterval = time.Second
+	defaultBatchJobPollMaxInterval     = 30 * time.Second
+)
+
+// WaitForBatchJobOptions tunes how WaitForBatchJob polls a batch job.
+type WaitForBatchJobOptions struct {
+	// InitialInterval is the wait before the second poll. Zero uses one
+	// second.
+	InitialInterval time.Duration
+	// MaxInterval caps the wait between polls. Zero uses 30 seconds.
+	MaxInterval time.Duration
+	// Progress, when set, is called with every status polled, including the
+	// final one, e.g. to report TasksReceived, TasksCreated and
+	// TasksErrored.
+	Progress func(status onfleet.TaskBatchStatusResponseAsync)
+}
+
+// WaitForBatchJob polls the status of a job created with BatchCreateAsync,
+// backing off exponentially between polls, until the job completes or
+// errors. It returns the final status, along with ErrBatchJobErrored when
+// the job errored or ErrBatchJobUnknownStatus when the status is empty or
+// unknown. Cancel ctx or give it a deadline to stop waiting; the
+// last status polled is returned with the error. opts may be nil.
+func (c *Client) WaitForBatchJob(ctx context.Context, batchJobId string, opts *WaitForBatchJobOptions) (onfleet.TaskBatchStatusResponseAsync, error) {
+	options := WaitForBatchJobOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = defaultBatchJobPollInitialInterval
+	if options.InitialInterval > 0 {
+		b.InitialInterval = options.InitialInterval
+	}
+	b.MaxInterval = defaultBatchJobPollMaxInterval
+	if options.MaxInterval > 0 {
+		b.MaxInterval = options.MaxInterval
+	}
+	b.MaxElapsedTime = 0
+	b.Reset()
+
+	var last onfleet.TaskBatchStatusResponseAsync
+	for {
+		status, err := c.GetBatchJobStatus(ctx, batchJobId)
+		if err != nil {
+			return last, err
+		}
+		last = status
+		if options.Progress != nil {
+			options.Progress(status)
+		}
+		switch status.Status {
+		case onfleet.TaskBatchJobStatusPending, onfleet.TaskBatchJobStatusProcessing:
+		case onfleet.TaskBatchJobStatusCompleted:
+			return status, nil
+		case onfleet.TaskBatchJobStatusErrored:
+			return status, fmt.Errorf("%w: %s", ErrBatchJobErrored, batchJobId)
+		default:
+			return status, fmt.Errorf("%w %q: %s", ErrBatchJobUnknownStatus, status.Status, batchJobId)
+		}
+
+		timer := time.NewTimer(b.NextBackOff())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
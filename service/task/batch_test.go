@@ -0,0 +1,306 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/onfleet/gonfleet"
+	"github.com/onfleet/gonfleet/netwrk"
+	"github.com/onfleet/gonfleet/testingutil"
+)
+
+// batchCaller creates every task it receives, except tasks whose notes are
+// in reject, which are echoed back as errors. Batches containing a task
+// whose notes are in fail are rejected as a whole.
+type batchCaller struct {
+	mu     sync.Mutex
+	sizes  []int
+	reject map[string]bool
+	fail   map[string]bool
+}
+
+func (b *batchCaller) call(ctx context.Context, apiKey string, rlHttpClient *netwrk.RlHttpClient, method string, baseUrl string, pathSegments []string, queryParams any, body any, v any, additionalHeaders ...[2]string) error {
+	params := body.(onfleet.TaskBatchCreateParams)
+	b.mu.Lock()
+	b.sizes = append(b.sizes, len(params.Tasks))
+	b.mu.Unlock()
+
+	response := onfleet.TaskBatchCreateResponse{}
+	for _, task := range params.Tasks {
+		if b.fail[task.Notes] {
+			return onfleet.RequestError{
+				Code:       "InternalServerError",
+				Message:    onfleet.RequestErrorMessage{Message: "batch failed"},
+				HTTPStatus: 500,
+			}
+		}
+		if b.reject[task.Notes] {
+			response.Errors = append(response.Errors, onfleet.TaskBatchCreateError{
+				Error: onfleet.RequestErrorMessage{Message: "invalid task", StatusCode: 400},
+				Task:  task,
+			})
+			continue
+		}
+		response.Tasks = append(response.Tasks, onfleet.Task{ID: "id_" + task.Notes, Notes: task.Notes})
+	}
+	raw, _ := json.Marshal(response)
+	return json.Unmarshal(raw, v)
+}
+
+func orderTasks(n int) []onfleet.TaskParams {
+	tasks := make([]onfleet.TaskParams, n)
+	for i := range tasks {
+		tasks[i] = onfleet.TaskParams{
+			Notes: fmt.Sprintf("order-%d", i),
+			Destination: onfleet.NewDestination(onfleet.DestinationCreateParams{
+				Address: onfleet.DestinationAddress{Unparsed: fmt.Sprintf("%d Main St", i)},
+			}),
+		}
+	}
+	return tasks
+}
+
+func TestClient_BatchCreate_ErrorIndex(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	tasks := orderTasks(3)
+	// Onfleet echoes the task back as JSON
+	var echoed onfleet.TaskParams
+	raw, _ := json.Marshal(tasks[2])
+	json.Unmarshal(raw, &echoed)
+
+	mockClient.AddResponse("/tasks/batch", testingutil.MockResponse{
+		StatusCode: 200,
+		Body: onfleet.TaskBatchCreateResponse{
+			Tasks: []onfleet.Task{{ID: "t0"}, {ID: "t1"}},
+			Errors: []onfleet.TaskBatchCreateError{
+				{Error: onfleet.RequestErrorMessage{Message: "invalid"}, Task: echoed},
+				{Error: onfleet.RequestErrorMessage{Message: "unknown"}, Task: onfleet.TaskParams{Notes: "other"}},
+			},
+		},
+	})
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	response, err := client.BatchCreate(context.Background(), onfleet.TaskBatchCreateParams{Tasks: tasks})
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Errors, 2)
+	assert.Equal(t, 2, response.Errors[0].Index)
+	assert.Equal(t, -1, response.Errors[1].Index)
+}
+
+func TestClient_BatchCreateAll(t *testing.T) {
+	caller := &batchCaller{reject: map[string]bool{"order-3": true, "order-150": true, "order-249": true}}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	response, err := client.BatchCreateAll(context.Background(), onfleet.TaskBatchCreateParams{Tasks: orderTasks(250)}, &BatchCreateOptions{
+		Concurrency: 3,
+	})
+
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{100, 100, 50}, caller.sizes)
+	assert.Len(t, response.Tasks, 247)
+	assert.Equal(t, "id_order-0", response.Tasks[0].ID)
+	assert.Equal(t, "id_order-248", response.Tasks[246].ID)
+	var indexes []int
+	for _, batchErr := range response.Errors {
+		indexes = append(indexes, batchErr.Index)
+		assert.Equal(t, fmt.Sprintf("order-%d", batchErr.Index), batchErr.Task.Notes)
+	}
+	assert.Equal(t, []int{3, 150, 249}, indexes)
+}
+
+// Run with -race: the chunks share one ResponseMeta.
+func TestClient_BatchCreateAll_ResponseMeta(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req_123")
+		w.Write([]byte(`{"tasks": [], "errors": []}`))
+	}))
+	defer server.Close()
+
+	rlHttpClient := netwrk.NewRlHttpClient(netwrk.NewRateLimiter(1000), 5000)
+	client := Plug("test_api_key", rlHttpClient, server.URL+"/tasks", netwrk.Call)
+
+	var meta netwrk.ResponseMeta
+	ctx := netwrk.WithResponseMeta(context.Background(), &meta)
+	_, err := client.BatchCreateAll(ctx, onfleet.TaskBatchCreateParams{Tasks: orderTasks(30)}, &BatchCreateOptions{
+		ChunkSize:   5,
+		Concurrency: 3,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 200, meta.StatusCode)
+	assert.Equal(t, "req_123", meta.RequestId)
+}
+
+func TestClient_BatchCreateAll_ChunkFailure(t *testing.T) {
+	caller := &batchCaller{fail: map[string]bool{"order-12": true}}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	response, err := client.BatchCreateAll(context.Background(), onfleet.TaskBatchCreateParams{Tasks: orderTasks(25)}, &BatchCreateOptions{
+		ChunkSize: 10,
+	})
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "batch of tasks 10 to 19")
+	assert.Equal(t, []int{10, 10, 5}, caller.sizes)
+	assert.Len(t, response.Tasks, 15)
+	assert.Len(t, response.Errors, 10)
+	for i, batchErr := range response.Errors {
+		assert.Equal(t, 10+i, batchErr.Index)
+		assert.Equal(t, "batch failed", batchErr.Error.Message)
+		assert.Equal(t, 500, batchErr.Error.StatusCode)
+	}
+}
+
+func TestClient_BatchCreateAll_Empty(t *testing.T) {
+	caller := &batchCaller{}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	response, err := client.BatchCreateAll(context.Background(), onfleet.TaskBatchCreateParams{}, nil)
+
+	assert.NoError(t, err)
+	assert.Empty(t, caller.sizes)
+	assert.Empty(t, response.Tasks)
+	assert.Empty(t, response.Errors)
+}
+
+func batchJobStatus(status string, received int, created int, errored int) testingutil.MockResponse {
+	return testingutil.MockResponse{
+		StatusCode: 200,
+		Body: map[string]any{
+			"status":        status,
+			"tasksReceived": received,
+			"tasksCreated":  created,
+			"tasksErrored":  errored,
+		},
+	}
+}
+
+func TestClient_WaitForBatchJob(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponseSequence("/tasks/batch/job_123",
+		batchJobStatus("PENDING", 0, 0, 0),
+		batchJobStatus("PROCESSING", 3, 1, 0),
+		batchJobStatus("COMPLETED", 3, 2, 1),
+	)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	var progress [][3]int
+	status, err := client.WaitForBatchJob(context.Background(), "job_123", &WaitForBatchJobOptions{
+		InitialInterval: time.Millisecond,
+		Progress: func(status onfleet.TaskBatchStatusResponseAsync) {
+			progress = append(progress, [3]int{status.TasksReceived, status.TasksCreated, status.TasksErrored})
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, onfleet.TaskBatchJobStatusCompleted, status.Status)
+	assert.Equal(t, [][3]int{{0, 0, 0}, {3, 1, 0}, {3, 2, 1}}, progress)
+	assert.Equal(t, 3, mockClient.GetRequestCount())
+}
+
+func TestClient_WaitForBatchJob_Errored(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponseSequence("/tasks/batch/job_123",
+		batchJobStatus("processing", 2, 0, 0),
+		batchJobStatus("errored", 2, 0, 2),
+	)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	status, err := client.WaitForBatchJob(context.Background(), "job_123", &WaitForBatchJobOptions{
+		InitialInterval: time.Millisecond,
+	})
+
+	assert.ErrorIs(t, err, ErrBatchJobErrored)
+	assert.Equal(t, onfleet.TaskBatchJobStatusErrored, status.Status)
+	assert.Equal(t, 2, status.TasksErrored)
+}
+
+func TestClient_WaitForBatchJob_UnknownStatus(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponseSequence("/tasks/batch/job_123",
+		batchJobStatus("pending", 0, 0, 0),
+		batchJobStatus("", 0, 0, 0),
+	)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	status, err := client.WaitForBatchJob(context.Background(), "job_123", &WaitForBatchJobOptions{
+		InitialInterval: time.Millisecond,
+	})
+
+	assert.ErrorIs(t, err, ErrBatchJobUnknownStatus)
+	assert.Equal(t, onfleet.TaskBatchJobStatus(""), status.Status)
+	assert.Equal(t, 2, mockClient.GetRequestCount())
+}
+
+func TestClient_WaitForBatchJob_ContextDeadline(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponseSequence("/tasks/batch/job_123", batchJobStatus("processing", 1, 0, 0))
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	status, err := client.WaitForBatchJob(ctx, "job_123", &WaitForBatchJobOptions{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     2 * time.Millisecond,
+	})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, onfleet.TaskBatchJobStatusProcessing, status.Status)
+	assert.Greater(t, mockClient.GetRequestCount(), 1)
+}
+
+func TestClient_WaitForBatchJob_NotFound(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponse("/tasks/batch/missing", testingutil.MockResponse{
+		StatusCode: 404,
+		Body:       testingutil.GetSampleErrorResponse(),
+	})
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	_, err := client.WaitForBatchJob(context.Background(), "missing", nil)
+
+	assert.ErrorIs(t, err, onfleet.ErrNotFound)
+	assert.Equal(t, 1, mockClient.GetRequestCount())
+}
+
+func TestClient_BatchCreateAll_ClientValidation(t *testing.T) {
+	caller := &batchCaller{}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	tasks := orderTasks(25)
+	tasks[17].Destination = &onfleet.DestinationRef{}
+
+	_, err := client.BatchCreateAll(context.Background(), onfleet.TaskBatchCreateParams{Tasks: tasks}, &BatchCreateOptions{
+		ChunkSize: 10,
+	})
+
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+	assert.ErrorContains(t, err, "tasks[17].destination")
+	assert.Empty(t, caller.sizes)
+}
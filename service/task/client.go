@@ -1,10 +1,12 @@
 package task
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/onfleet/gonfleet"
 	"github.com/onfleet/gonfleet/netwrk"
+	"github.com/onfleet/gonfleet/pagination"
 )
 
 type Client struct {
@@ -24,9 +26,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/get-single-task
-func (c *Client) Get(taskId string) (onfleet.Task, error) {
+func (c *Client) Get(ctx context.Context, taskId string) (onfleet.Task, error) {
 	task := onfleet.Task{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -40,9 +43,10 @@ func (c *Client) Get(taskId string) (onfleet.Task, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/get-single-task-by-shortid
-func (c *Client) GetByShortId(taskShortId string) (onfleet.Task, error) {
+func (c *Client) GetByShortId(ctx context.Context, taskShortId string) (onfleet.Task, error) {
 	task := onfleet.Task{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -56,9 +60,10 @@ func (c *Client) GetByShortId(taskShortId string) (onfleet.Task, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/list-tasks
-func (c *Client) List(params onfleet.TaskListQueryParams) (onfleet.TasksPaginated, error) {
+func (c *Client) List(ctx context.Context, params onfleet.TaskListQueryParams) (onfleet.TasksPaginated, error) {
 	paginatedTasks := onfleet.TasksPaginated{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -71,10 +76,23 @@ func (c *Client) List(params onfleet.TaskListQueryParams) (onfleet.TasksPaginate
 	return paginatedTasks, err
 }
 
+// ListAll returns a paginator over every task matched by params, following
+// lastId from page to page. maxItems caps the number of tasks returned; zero
+// means no cap. Every page is fetched through the client's rate limiter.
+func (c *Client) ListAll(ctx context.Context, params onfleet.TaskListQueryParams, maxItems int) *pagination.Paginator[onfleet.Task] {
+	fetch := func(ctx context.Context, lastId string) ([]onfleet.Task, string, error) {
+		params.LastId = lastId
+		page, err := c.List(ctx, params)
+		return page.Tasks, page.LastId, err
+	}
+	return pagination.New(ctx, params.LastId, fetch, maxItems)
+}
+
 // Reference https://docs.onfleet.com/reference/querying-by-metadata
-func (c *Client) ListWithMetadataQuery(metadata []onfleet.Metadata) ([]onfleet.Task, error) {
+func (c *Client) ListWithMetadataQuery(ctx context.Context, metadata []onfleet.Metadata) ([]onfleet.Task, error) {
 	tasks := []onfleet.Task{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -88,9 +106,13 @@ func (c *Client) ListWithMetadataQuery(metadata []onfleet.Metadata) ([]onfleet.T
 }
 
 // Reference https://docs.onfleet.com/reference/create-task
-func (c *Client) Create(params onfleet.TaskParams) (onfleet.Task, error) {
+func (c *Client) Create(ctx context.Context, params onfleet.TaskParams) (onfleet.Task, error) {
 	task := onfleet.Task{}
+	if err := validate(ctx, params); err != nil {
+		return task, err
+	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -104,9 +126,13 @@ func (c *Client) Create(params onfleet.TaskParams) (onfleet.Task, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/create-tasks-in-batch
-func (c *Client) BatchCreate(params onfleet.TaskBatchCreateParams) (onfleet.TaskBatchCreateResponse, error) {
+func (c *Client) BatchCreate(ctx context.Context, params onfleet.TaskBatchCreateParams) (onfleet.TaskBatchCreateResponse, error) {
 	batchTasks := onfleet.TaskBatchCreateResponse{}
+	if err := validate(ctx, params); err != nil {
+		return batchTasks, err
+	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -116,13 +142,18 @@ func (c *Client) BatchCreate(params onfleet.TaskBatchCreateParams) (onfleet.Task
 		params,
 		&batchTasks,
 	)
+	indexBatchErrors(batchTasks.Errors, params.Tasks)
 	return batchTasks, err
 }
 
 // Reference https://docs.onfleet.com/reference/create-tasks-in-batch-async
-func (c *Client) BatchCreateAsync(params onfleet.TaskBatchCreateParams) (onfleet.TaskBatchCreateResponseAsync, error) {
+func (c *Client) BatchCreateAsync(ctx context.Context, params onfleet.TaskBatchCreateParams) (onfleet.TaskBatchCreateResponseAsync, error) {
 	batchRes := onfleet.TaskBatchCreateResponseAsync{}
+	if err := validate(ctx, params); err != nil {
+		return batchRes, err
+	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -136,9 +167,10 @@ func (c *Client) BatchCreateAsync(params onfleet.TaskBatchCreateParams) (onfleet
 }
 
 // Reference https://docs.onfleet.com/reference/batch-job-status
-func (c *Client) GetBatchJobStatus(batchJobId string) (onfleet.TaskBatchStatusResponseAsync, error) {
+func (c *Client) GetBatchJobStatus(ctx context.Context, batchJobId string) (onfleet.TaskBatchStatusResponseAsync, error) {
 	batchStatus := onfleet.TaskBatchStatusResponseAsync{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -151,10 +183,15 @@ func (c *Client) GetBatchJobStatus(batchJobId string) (onfleet.TaskBatchStatusRe
 	return batchStatus, err
 }
 
+// Update sends only the fields of params that are set.
 // Reference https://docs.onfleet.com/reference/update-task
-func (c *Client) Update(taskId string, params onfleet.TaskParams) (onfleet.Task, error) {
+func (c *Client) Update(ctx context.Context, taskId string, params onfleet.TaskUpdateParams) (onfleet.Task, error) {
 	task := onfleet.Task{}
+	if err := validate(ctx, params); err != nil {
+		return task, err
+	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -168,8 +205,9 @@ func (c *Client) Update(taskId string, params onfleet.TaskParams) (onfleet.Task,
 }
 
 // Reference https://docs.onfleet.com/reference/complete-task
-func (c *Client) ForceComplete(taskId string, params onfleet.TaskForceCompletionParams) error {
+func (c *Client) ForceComplete(ctx context.Context, taskId string, params onfleet.TaskForceCompletionParams) error {
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -183,9 +221,15 @@ func (c *Client) ForceComplete(taskId string, params onfleet.TaskForceCompletion
 }
 
 // Reference https://docs.onfleet.com/reference/clone-task
-func (c *Client) Clone(taskId string, params *onfleet.TaskCloneParams) (onfleet.Task, error) {
+func (c *Client) Clone(ctx context.Context, taskId string, params *onfleet.TaskCloneParams) (onfleet.Task, error) {
 	task := onfleet.Task{}
+	if params != nil {
+		if err := validate(ctx, *params); err != nil {
+			return task, err
+		}
+	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -199,8 +243,9 @@ func (c *Client) Clone(taskId string, params *onfleet.TaskCloneParams) (onfleet.
 }
 
 // Reference https://docs.onfleet.com/reference/delete-task
-func (c *Client) Delete(taskId string) error {
+func (c *Client) Delete(ctx context.Context, taskId string) error {
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodDelete,
@@ -214,9 +259,10 @@ func (c *Client) Delete(taskId string) error {
 }
 
 // Reference https://docs.onfleet.com/reference/automatically-assign-list-of-tasks
-func (c *Client) AutoAssignMulti(params onfleet.TaskAutoAssignMultiParams) (onfleet.TaskAutoAssignMultiResponse, error) {
+func (c *Client) AutoAssignMulti(ctx context.Context, params onfleet.TaskAutoAssignMultiParams) (onfleet.TaskAutoAssignMultiResponse, error) {
 	autoAssignMulti := onfleet.TaskAutoAssignMultiResponse{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -231,14 +277,18 @@ func (c *Client) AutoAssignMulti(params onfleet.TaskAutoAssignMultiParams) (onfl
 
 // Reference https://docs.onfleet.com/reference/metadata
 // MetadataSet atomically adds or updates metadata fields without affecting other metadata
-func (c *Client) MetadataSet(taskId string, metadata ...onfleet.Metadata) (onfleet.Task, error) {
+func (c *Client) MetadataSet(ctx context.Context, taskId string, metadata ...onfleet.Metadata) (onfleet.Task, error) {
 	task := onfleet.Task{}
+	if err := onfleet.ValidateMetadata(metadata); err != nil {
+		return task, err
+	}
 	body := map[string]any{
 		"metadata": map[string]any{
 			"$set": metadata,
 		},
 	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -253,7 +303,7 @@ func (c *Client) MetadataSet(taskId string, metadata ...onfleet.Metadata) (onfle
 
 // Reference https://docs.onfleet.com/reference/metadata
 // MetadataPop atomically removes metadata fields without affecting other metadata
-func (c *Client) MetadataPop(taskId string, names ...string) (onfleet.Task, error) {
+func (c *Client) MetadataPop(ctx context.Context, taskId string, names ...string) (onfleet.Task, error) {
 	task := onfleet.Task{}
 
 	popArray := make([]map[string]string, len(names))
@@ -267,6 +317,7 @@ func (c *Client) MetadataPop(taskId string, names ...string) (onfleet.Task, erro
 		},
 	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -1,6 +1,7 @@
 package task
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,7 +24,7 @@ func TestClient_Get(t *testing.T) {
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
 	// Test Get method
-	task, err := client.Get("task_123")
+	task, err := client.Get(context.Background(), "task_123")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTask.ID, task.ID)
@@ -47,12 +48,33 @@ func TestClient_Get_NotFound(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
-	task, err := client.Get("nonexistent")
+	task, err := client.Get(context.Background(), "nonexistent")
 
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, onfleet.ErrNotFound)
 	assert.Equal(t, "", task.ID) // Empty task on error
 }
 
+func TestClient_Get_ContextCanceled(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponse("/tasks/task_123", testingutil.MockResponse{
+		StatusCode: 200,
+		Body:       testingutil.GetSampleTask(),
+	})
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	task, err := client.Get(ctx, "task_123")
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "", task.ID)
+	assert.Equal(t, 0, mockClient.GetRequestCount())
+}
+
 func TestClient_GetByShortId(t *testing.T) {
 	mockClient := testingutil.SetupTest(t)
 	defer testingutil.CleanupTest(t, mockClient)
@@ -65,7 +87,7 @@ func TestClient_GetByShortId(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
-	task, err := client.GetByShortId("abc123")
+	task, err := client.GetByShortId(context.Background(), "abc123")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTask.ID, task.ID)
@@ -98,7 +120,7 @@ func TestClient_List(t *testing.T) {
 		Worker: "worker_123",
 	}
 
-	tasks, err := client.List(params)
+	tasks, err := client.List(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Len(t, tasks.Tasks, 1)
@@ -107,6 +129,89 @@ func TestClient_List(t *testing.T) {
 	mockClient.AssertRequestMade("GET", "/tasks")
 }
 
+func TestClient_ListAll(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponseSequence("/tasks/all",
+		testingutil.MockResponse{StatusCode: 200, Body: onfleet.TasksPaginated{
+			Tasks:  []onfleet.Task{{ID: "t1"}, {ID: "t2"}},
+			LastId: "t2",
+		}},
+		testingutil.MockResponse{StatusCode: 200, Body: onfleet.TasksPaginated{
+			Tasks:  []onfleet.Task{{ID: "t3"}, {ID: "t4"}},
+			LastId: "t4",
+		}},
+		testingutil.MockResponse{StatusCode: 200, Body: onfleet.TasksPaginated{
+			Tasks: []onfleet.Task{{ID: "t5"}},
+		}},
+	)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	tasks, err := client.ListAll(context.Background(), onfleet.TaskListQueryParams{From: 1640995200000}, 0).All()
+
+	assert.NoError(t, err)
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	assert.Equal(t, []string{"t1", "t2", "t3", "t4", "t5"}, ids)
+
+	var lastIds []string
+	for _, q := range mockClient.QueryHistory {
+		params := q.(onfleet.TaskListQueryParams)
+		assert.Equal(t, int64(1640995200000), params.From)
+		lastIds = append(lastIds, params.LastId)
+	}
+	assert.Equal(t, []string{"", "t2", "t4"}, lastIds)
+}
+
+func TestClient_ListAll_MaxItems(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponseSequence("/tasks/all",
+		testingutil.MockResponse{StatusCode: 200, Body: onfleet.TasksPaginated{
+			Tasks:  []onfleet.Task{{ID: "t1"}, {ID: "t2"}},
+			LastId: "t2",
+		}},
+		testingutil.MockResponse{StatusCode: 200, Body: onfleet.TasksPaginated{
+			Tasks:  []onfleet.Task{{ID: "t3"}, {ID: "t4"}},
+			LastId: "t4",
+		}},
+	)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	tasks, err := client.ListAll(context.Background(), onfleet.TaskListQueryParams{}, 3).All()
+
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 3)
+	assert.Equal(t, 2, mockClient.GetRequestCount())
+}
+
+func TestClient_ListAll_Error(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponseSequence("/tasks/all",
+		testingutil.MockResponse{StatusCode: 200, Body: onfleet.TasksPaginated{
+			Tasks:  []onfleet.Task{{ID: "t1"}},
+			LastId: "t1",
+		}},
+		testingutil.MockResponse{StatusCode: 401, Body: testingutil.GetSampleErrorResponse()},
+	)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	it := client.ListAll(context.Background(), onfleet.TaskListQueryParams{}, 0)
+	assert.True(t, it.Next())
+	assert.Equal(t, "t1", it.Item().ID)
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), onfleet.ErrUnauthorized)
+}
+
 func TestClient_ListWithMetadataQuery(t *testing.T) {
 	mockClient := testingutil.SetupTest(t)
 	defer testingutil.CleanupTest(t, mockClient)
@@ -130,7 +235,7 @@ func TestClient_ListWithMetadataQuery(t *testing.T) {
 		},
 	}
 
-	tasks, err := client.ListWithMetadataQuery(metadata)
+	tasks, err := client.ListWithMetadataQuery(context.Background(), metadata)
 
 	assert.NoError(t, err)
 	assert.Len(t, tasks, 1)
@@ -153,7 +258,7 @@ func TestClient_Create(t *testing.T) {
 
 	params := testingutil.GetSampleTaskParams()
 
-	task, err := client.Create(params)
+	task, err := client.Create(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTask.ID, task.ID)
@@ -169,11 +274,6 @@ func TestClient_Create_ValidationError(t *testing.T) {
 	mockClient := testingutil.SetupTest(t)
 	defer testingutil.CleanupTest(t, mockClient)
 
-	mockClient.AddResponse("/tasks", testingutil.MockResponse{
-		StatusCode: 400,
-		Body:       testingutil.GetSampleValidationErrorResponse(),
-	})
-
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
 	// Invalid params - missing required fields
@@ -182,10 +282,12 @@ func TestClient_Create_ValidationError(t *testing.T) {
 		// Missing destination and recipients
 	}
 
-	task, err := client.Create(params)
+	task, err := client.Create(context.Background(), params)
 
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+	assert.ErrorContains(t, err, "destination: is required")
 	assert.Equal(t, "", task.ID)
+	assert.Equal(t, 0, mockClient.GetRequestCount())
 }
 
 func TestClient_BatchCreate(t *testing.T) {
@@ -212,7 +314,7 @@ func TestClient_BatchCreate(t *testing.T) {
 		},
 	}
 
-	response, err := client.BatchCreate(params)
+	response, err := client.BatchCreate(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Len(t, response.Tasks, 1)
@@ -243,11 +345,11 @@ func TestClient_BatchCreateAsync(t *testing.T) {
 		},
 	}
 
-	response, err := client.BatchCreateAsync(params)
+	response, err := client.BatchCreateAsync(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "job_123", response.JobID)
-	assert.Equal(t, "PENDING", response.Status)
+	assert.Equal(t, onfleet.TaskBatchJobStatusPending, response.Status)
 
 	mockClient.AssertRequestMade("POST", "/tasks/batch-async")
 }
@@ -277,10 +379,10 @@ func TestClient_GetBatchJobStatus(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
-	response, err := client.GetBatchJobStatus("job_123")
+	response, err := client.GetBatchJobStatus(context.Background(), "job_123")
 
 	assert.NoError(t, err)
-	assert.Equal(t, "COMPLETED", response.Status)
+	assert.Equal(t, onfleet.TaskBatchJobStatusCompleted, response.Status)
 	assert.Equal(t, 1, response.TasksCreated)
 	assert.Len(t, response.NewTasks, 1)
 
@@ -301,11 +403,12 @@ func TestClient_Update(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
-	params := onfleet.TaskParams{
-		Notes: "Updated notes",
+	params := onfleet.TaskUpdateParams{
+		Notes:          onfleet.Set("Updated notes"),
+		CompleteBefore: onfleet.Null[int64](),
 	}
 
-	task, err := client.Update("task_123", params)
+	task, err := client.Update(context.Background(), "task_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTask.ID, task.ID)
@@ -332,7 +435,7 @@ func TestClient_ForceComplete(t *testing.T) {
 		},
 	}
 
-	err := client.ForceComplete("task_123", params)
+	err := client.ForceComplete(context.Background(), "task_123", params)
 
 	assert.NoError(t, err)
 	mockClient.AssertRequestMade("POST", "/tasks/task_123/complete")
@@ -362,7 +465,7 @@ func TestClient_Clone(t *testing.T) {
 		},
 	}
 
-	task, err := client.Clone("task_123", params)
+	task, err := client.Clone(context.Background(), "task_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "cloned_task_456", task.ID)
@@ -385,12 +488,29 @@ func TestClient_Clone_NilParams(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
-	task, err := client.Clone("task_123", nil)
+	task, err := client.Clone(context.Background(), "task_123", nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "cloned_task_456", task.ID)
 }
 
+func TestClient_Clone_ClientValidation(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	_, err := client.Clone(context.Background(), "task_123", &onfleet.TaskCloneParams{
+		Overrides: &onfleet.TaskCloneOverridesParam{
+			Recipients: onfleet.RecipientIDs(""),
+		},
+	})
+
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+	assert.ErrorContains(t, err, "overrides.recipients[0]")
+	assert.Equal(t, 0, mockClient.GetRequestCount())
+}
+
 func TestClient_Delete(t *testing.T) {
 	mockClient := testingutil.SetupTest(t)
 	defer testingutil.CleanupTest(t, mockClient)
@@ -402,7 +522,7 @@ func TestClient_Delete(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
-	err := client.Delete("task_123")
+	err := client.Delete(context.Background(), "task_123")
 
 	assert.NoError(t, err)
 	mockClient.AssertRequestMade("DELETE", "/tasks/task_123")
@@ -419,7 +539,7 @@ func TestClient_Delete_NotFound(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
-	err := client.Delete("nonexistent")
+	err := client.Delete(context.Background(), "nonexistent")
 
 	assert.Error(t, err)
 }
@@ -451,7 +571,7 @@ func TestClient_AutoAssignMulti(t *testing.T) {
 		},
 	}
 
-	response, err := client.AutoAssignMulti(params)
+	response, err := client.AutoAssignMulti(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, 2, response.AssignedTasksCount)
@@ -484,7 +604,7 @@ func TestClient_AutoAssignMulti_NoAssignments(t *testing.T) {
 		},
 	}
 
-	response, err := client.AutoAssignMulti(params)
+	response, err := client.AutoAssignMulti(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, 0, response.AssignedTasksCount)
@@ -518,7 +638,7 @@ func TestClient_Get_DifferentStates(t *testing.T) {
 
 			client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
-			task, err := client.Get("task_123")
+			task, err := client.Get(context.Background(), "task_123")
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.state, task.State)
@@ -551,7 +671,7 @@ func TestClient_DifferentConfigurations(t *testing.T) {
 
 			client := Plug(tt.apiKey, nil, tt.url, mockClient.MockCaller)
 
-			task, err := client.Get("task_123")
+			task, err := client.Get(context.Background(), "task_123")
 
 			assert.NoError(t, err)
 			assert.Equal(t, expectedTask.ID, task.ID)
@@ -599,7 +719,7 @@ func TestClient_List_FilterByState(t *testing.T) {
 				State: tt.state,
 			}
 
-			tasks, err := client.List(params)
+			tasks, err := client.List(context.Background(), params)
 
 			assert.NoError(t, err)
 			assert.Len(t, tasks.Tasks, 1)
@@ -645,7 +765,7 @@ func TestClient_List_FilterByContainer(t *testing.T) {
 				Containers: tt.containers,
 			}
 
-			tasks, err := client.List(params)
+			tasks, err := client.List(context.Background(), params)
 
 			assert.NoError(t, err)
 			assert.Len(t, tasks.Tasks, 1)
@@ -685,7 +805,7 @@ func TestClient_MetadataSet(t *testing.T) {
 		},
 	}
 
-	task, err := client.MetadataSet("task_123", metadata...)
+	task, err := client.MetadataSet(context.Background(), "task_123", metadata...)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTask.ID, task.ID)
@@ -698,6 +818,23 @@ func TestClient_MetadataSet(t *testing.T) {
 	mockClient.AssertRequestMade("PUT", "/tasks/task_123")
 }
 
+func TestClient_MetadataSet_ClientValidation(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	_, err := client.MetadataSet(context.Background(), "task_123",
+		onfleet.Metadata{Name: "count", Type: "number", Value: "3"},
+		onfleet.Metadata{Name: "count", Type: "number", Value: 3},
+	)
+
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+	assert.ErrorContains(t, err, "metadata[0].value")
+	assert.ErrorContains(t, err, "metadata[1].name")
+	assert.Equal(t, 0, mockClient.GetRequestCount())
+}
+
 func TestClient_MetadataSet_Atomicity(t *testing.T) {
 	mockClient := testingutil.SetupTest(t)
 	defer testingutil.CleanupTest(t, mockClient)
@@ -733,7 +870,7 @@ func TestClient_MetadataSet_Atomicity(t *testing.T) {
 		},
 	}
 
-	task, err := client.MetadataSet("task_123", metadata...)
+	task, err := client.MetadataSet(context.Background(), "task_123", metadata...)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTask.ID, task.ID)
@@ -773,7 +910,7 @@ func TestClient_MetadataPop(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
-	task, err := client.MetadataPop("task_123", "error")
+	task, err := client.MetadataPop(context.Background(), "task_123", "error")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTask.ID, task.ID)
@@ -808,7 +945,7 @@ func TestClient_MetadataPop_Atomicity(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
 
-	task, err := client.MetadataPop("task_123", "field_to_remove")
+	task, err := client.MetadataPop(context.Background(), "task_123", "field_to_remove")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTask.ID, task.ID)
@@ -824,4 +961,56 @@ func TestClient_MetadataPop_Atomicity(t *testing.T) {
 	}
 
 	mockClient.AssertRequestMade("PUT", "/tasks/task_123")
-}
\ No newline at end of file
+}
+func TestClient_Create_ClientValidation(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponse("/tasks", testingutil.MockResponse{
+		StatusCode: 200,
+		Body:       testingutil.GetSampleTask(),
+	})
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	params := testingutil.GetSampleTaskParams()
+	params.CompleteAfter = params.CompleteBefore + 1
+
+	_, err := client.Create(context.Background(), params)
+
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+	assert.ErrorContains(t, err, "completeAfter")
+	assert.Equal(t, 0, mockClient.GetRequestCount())
+
+	_, err = client.Update(context.Background(), "task_123", params.UpdateParams())
+
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+	assert.Equal(t, 0, mockClient.GetRequestCount())
+
+	task, err := client.Create(WithoutValidation(context.Background()), params)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, task.ID)
+	assert.Equal(t, 1, mockClient.GetRequestCount())
+}
+
+func TestClient_BatchCreate_ClientValidation(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", mockClient.MockCaller)
+
+	invalid := testingutil.GetSampleTaskParams()
+	invalid.Barcodes = []onfleet.TaskBarcode{{BlockCompletion: true}}
+	params := onfleet.TaskBatchCreateParams{
+		Tasks: []onfleet.TaskParams{testingutil.GetSampleTaskParams(), invalid},
+	}
+
+	_, err := client.BatchCreate(context.Background(), params)
+	assert.ErrorContains(t, err, "tasks[1].barcodes[0].data")
+
+	_, err = client.BatchCreateAsync(context.Background(), params)
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+
+	assert.Equal(t, 0, mockClient.GetRequestCount())
+}
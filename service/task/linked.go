@@ -0,0 +1,96 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/onfleet/gonfleet"
+)
+
+// LinkedCreateError is returned by CreateLinked and CreateChain when a leg
+// cannot be created.
+type LinkedCreateError struct {
+	// Leg is the index of the leg that failed.
+	Leg int
+	// Err is the error creating the leg.
+	Err error
+	// Orphaned holds the ids of earlier legs that were created but could not
+	// be deleted during rollback.
+	Orphaned []string
+	// RollbackErr joins the errors deleting the Orphaned tasks.
+	RollbackErr error
+}
+
+func (err *LinkedCreateError) Error() string {
+	msg := fmt.Sprintf("task: creating leg %d: %v", err.Leg, err.Err)
+	if len(err.Orphaned) > 0 {
+		msg += fmt.Sprintf("; rollback left tasks %s: %v", strings.Join(err.Orphaned, ", "), err.RollbackErr)
+	}
+	return msg
+}
+
+func (err *LinkedCreateError) Unwrap() []error {
+	if err.RollbackErr != nil {
+		return []error{err.Err, err.RollbackErr}
+	}
+	return []error{err.Err}
+}
+
+// CreateLinked creates pickup as a pickup task, then dropoff depending on
+// it. If dropoff cannot be created, pickup is deleted again and a
+// *LinkedCreateError is returned.
+func (c *Client) CreateLinked(ctx context.Context, pickup onfleet.TaskParams, dropoff onfleet.TaskParams) (onfleet.Task, onfleet.Task, error) {
+	pickup.PickupTask = true
+	tasks, err := c.CreateChain(ctx, pickup, dropoff)
+	if err != nil {
+		return onfleet.Task{}, onfleet.Task{}, err
+	}
+	return tasks[0], tasks[1], nil
+}
+
+// CreateChain creates legs in order, adding the id of each created task to
+// the Dependencies of the next leg. If a leg cannot be created, the legs
+// already created are deleted in reverse order and a *LinkedCreateError is
+// returned. Rollback runs even when ctx is cancelled. Every leg is validated
+// before the first is created, so an invalid leg creates nothing.
+func (c *Client) CreateChain(ctx context.Context, legs ...onfleet.TaskParams) ([]onfleet.Task, error) {
+	for i, leg := range legs {
+		if err := validate(ctx, leg); err != nil {
+			return nil, &LinkedCreateError{Leg: i, Err: err}
+		}
+	}
+	ctx = WithoutValidation(ctx)
+
+	tasks := make([]onfleet.Task, 0, len(legs))
+	for i, leg := range legs {
+		if i > 0 {
+			leg.Dependencies = append(append([]string(nil), leg.Dependencies...), tasks[i-1].ID)
+		}
+		task, err := c.Create(ctx, leg)
+		if err != nil {
+			return nil, c.rollbackChain(ctx, i, err, tasks)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// rollbackChain deletes the created tasks, last first, after leg failed
+// with err.
+func (c *Client) rollbackChain(ctx context.Context, leg int, err error, created []onfleet.Task) error {
+	linkedErr := &LinkedCreateError{Leg: leg, Err: err}
+	ctx = context.WithoutCancel(ctx)
+	var rollbackErrs []error
+	for i := len(created) - 1; i >= 0; i-- {
+		if deleteErr := c.Delete(ctx, created[i].ID); deleteErr != nil {
+			linkedErr.Orphaned = append(linkedErr.Orphaned, created[i].ID)
+			rollbackErrs = append(rollbackErrs, fmt.Errorf("deleting %s: %w", created[i].ID, deleteErr))
+		}
+	}
+	if len(rollbackErrs) > 0 {
+		linkedErr.RollbackErr = errors.Join(rollbackErrs...)
+	}
+	return linkedErr
+}
@@ -0,0 +1,159 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/onfleet/gonfleet"
+	"github.com/onfleet/gonfleet/netwrk"
+)
+
+// chainCaller creates tasks with sequential ids, failing the creation
+// numbered failCreate (1 based) and the deletion of ids in failDelete.
+type chainCaller struct {
+	created    []onfleet.TaskParams
+	deleted    []string
+	failCreate int
+	failDelete map[string]bool
+}
+
+func (c *chainCaller) call(ctx context.Context, apiKey string, rlHttpClient *netwrk.RlHttpClient, method string, baseUrl string, pathSegments []string, queryParams any, body any, v any, additionalHeaders ...[2]string) error {
+	switch method {
+	case http.MethodPost:
+		if len(c.created)+1 == c.failCreate {
+			return onfleet.RequestError{Code: "InvalidArgument", HTTPStatus: 400}
+		}
+		params := body.(onfleet.TaskParams)
+		c.created = append(c.created, params)
+		raw, _ := json.Marshal(onfleet.Task{
+			ID:           fmt.Sprintf("task_%d", len(c.created)),
+			PickupTask:   params.PickupTask,
+			Dependencies: params.Dependencies,
+		})
+		return json.Unmarshal(raw, v)
+	case http.MethodDelete:
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if c.failDelete[pathSegments[0]] {
+			return onfleet.RequestError{Code: "InternalServerError", HTTPStatus: 500}
+		}
+		c.deleted = append(c.deleted, pathSegments[0])
+		return nil
+	}
+	return errors.New("unexpected " + method)
+}
+
+// leg returns valid params for a leg of a chain.
+func leg(notes string) onfleet.TaskParams {
+	return onfleet.TaskParams{Destination: onfleet.DestinationID("dest_123"), Notes: notes}
+}
+
+func TestClient_CreateLinked(t *testing.T) {
+	caller := &chainCaller{}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	pickup, dropoff, err := client.CreateLinked(context.Background(),
+		leg("pickup"),
+		onfleet.TaskParams{Destination: onfleet.DestinationID("dest_123"), Notes: "dropoff", Dependencies: []string{"task_0"}},
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "task_1", pickup.ID)
+	assert.True(t, pickup.PickupTask)
+	assert.Equal(t, "task_2", dropoff.ID)
+	assert.False(t, dropoff.PickupTask)
+	assert.Equal(t, []string{"task_0", "task_1"}, caller.created[1].Dependencies)
+	assert.Empty(t, caller.deleted)
+}
+
+func TestClient_CreateLinked_Rollback(t *testing.T) {
+	caller := &chainCaller{failCreate: 2}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	pickup, dropoff, err := client.CreateLinked(context.Background(), leg("pickup"), leg("dropoff"))
+
+	var linkedErr *LinkedCreateError
+	assert.ErrorAs(t, err, &linkedErr)
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+	assert.Equal(t, 1, linkedErr.Leg)
+	assert.Empty(t, linkedErr.Orphaned)
+	assert.Equal(t, "", pickup.ID)
+	assert.Equal(t, "", dropoff.ID)
+	assert.Equal(t, []string{"task_1"}, caller.deleted)
+}
+
+func TestClient_CreateChain(t *testing.T) {
+	caller := &chainCaller{}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	legs := []onfleet.TaskParams{leg("a"), leg("b"), leg("c")}
+	tasks, err := client.CreateChain(context.Background(), legs...)
+
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 3)
+	assert.Empty(t, caller.created[0].Dependencies)
+	assert.Equal(t, []string{"task_1"}, caller.created[1].Dependencies)
+	assert.Equal(t, []string{"task_2"}, caller.created[2].Dependencies)
+	assert.Empty(t, legs[1].Dependencies) // caller legs are not modified
+}
+
+func TestClient_CreateChain_RollbackFailure(t *testing.T) {
+	caller := &chainCaller{failCreate: 4, failDelete: map[string]bool{"task_2": true}}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	tasks, err := client.CreateChain(context.Background(), leg("a"), leg("b"), leg("c"), leg("d"))
+
+	var linkedErr *LinkedCreateError
+	assert.ErrorAs(t, err, &linkedErr)
+	assert.Nil(t, tasks)
+	assert.Equal(t, 3, linkedErr.Leg)
+	assert.Equal(t, []string{"task_2"}, linkedErr.Orphaned)
+	assert.Error(t, linkedErr.RollbackErr)
+	assert.Equal(t, []string{"task_3", "task_1"}, caller.deleted)
+	assert.Contains(t, err.Error(), "rollback left tasks task_2")
+}
+
+func TestClient_CreateChain_InvalidLeg(t *testing.T) {
+	caller := &chainCaller{}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	tasks, err := client.CreateChain(context.Background(),
+		leg("a"),
+		leg("b"),
+		onfleet.TaskParams{Destination: onfleet.DestinationID("dest_123"), Quantity: -1},
+	)
+
+	var linkedErr *LinkedCreateError
+	assert.ErrorAs(t, err, &linkedErr)
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+	assert.Nil(t, tasks)
+	assert.Equal(t, 2, linkedErr.Leg)
+	assert.Empty(t, caller.created)
+	assert.Empty(t, caller.deleted)
+}
+
+func TestClient_CreateChain_RollbackAfterCancel(t *testing.T) {
+	caller := &chainCaller{}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelling := func(ctx context.Context, apiKey string, rlHttpClient *netwrk.RlHttpClient, method string, baseUrl string, pathSegments []string, queryParams any, body any, v any, additionalHeaders ...[2]string) error {
+		if method == http.MethodPost && len(caller.created) == 1 {
+			cancel()
+			return ctx.Err()
+		}
+		return caller.call(ctx, apiKey, rlHttpClient, method, baseUrl, pathSegments, queryParams, body, v, additionalHeaders...)
+	}
+	client.call = cancelling
+
+	_, err := client.CreateChain(ctx, leg("a"), leg("b"))
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"task_1"}, caller.deleted)
+}
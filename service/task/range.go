@@ -0,0 +1,150 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onfleet/gonfleet"
+	"github.com/onfleet/gonfleet/pagination"
+)
+
+// ListRangeOptions tunes how ListRange splits and fetches a range.
+type ListRangeOptions struct {
+	// Concurrency is the number of windows fetched at once. Zero or one
+	// fetches windows one after another. Every request still goes through
+	// the client's rate limiter.
+	Concurrency int
+	// MaxItems caps the number of tasks returned. Zero means no cap.
+	MaxItems int
+}
+
+type timeWindow struct {
+	from time.Time
+	to   time.Time
+}
+
+// splitRange splits [from, to] into consecutive windows no wider than width.
+// Adjacent windows share their boundary.
+func splitRange(from time.Time, to time.Time, width time.Duration) []timeWindow {
+	windows := []timeWindow{}
+	for start := from; ; {
+		end := start.Add(width)
+		if !end.Before(to) {
+			return append(windows, timeWindow{from: start, to: to})
+		}
+		windows = append(windows, timeWindow{from: start, to: end})
+		start = end
+	}
+}
+
+// windowPage is a page of a window, or the error that ended it.
+type windowPage struct {
+	tasks []onfleet.Task
+	err   error
+}
+
+// ListRange returns a paginator over every task matched by params between
+// from and to. The range is split into windows no wider than window, the
+// widest from / to range the account allows in a single query, each window
+// is paginated with lastId, and tasks are returned page by page in
+// chronological order. A task matched by several windows is only returned
+// once. params.From, params.To and params.LastId are ignored; opts may be
+// nil.
+//
+// With opts.Concurrency above one, up to that many windows are fetched ahead
+// of the consumer, each at most one page ahead. The windows in flight are
+// canceled once the paginator stops, whether it is exhausted, fails, reaches
+// opts.MaxItems or is closed.
+func (c *Client) ListRange(ctx context.Context, from time.Time, to time.Time, window time.Duration, params onfleet.TaskListQueryParams, opts *ListRangeOptions) *pagination.Paginator[onfleet.Task] {
+	options := ListRangeOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.Concurrency < 1 {
+		options.Concurrency = 1
+	}
+	var invalid error
+	switch {
+	case window <= 0:
+		invalid = fmt.Errorf("task: range window %v is not positive", window)
+	case to.Before(from):
+		invalid = fmt.Errorf("task: range end %v is before its start %v", to, from)
+	}
+	if invalid != nil {
+		fail := func(context.Context) ([]onfleet.Task, bool, error) {
+			return nil, false, invalid
+		}
+		return pagination.NewPaged(ctx, fail, options.MaxItems)
+	}
+
+	windows := splitRange(from, to, window)
+	results := make([]chan windowPage, len(windows))
+	started := 0
+	// ctx is the paginator's, canceled once it stops
+	start := func(ctx context.Context, upTo int) {
+		for ; started < len(windows) && started <= upTo; started++ {
+			span := windows[started]
+			result := make(chan windowPage)
+			results[started] = result
+			go func() {
+				defer close(result)
+				windowParams := params
+				windowParams.SetFrom(span.from)
+				windowParams.SetTo(span.to)
+				windowParams.LastId = ""
+				for {
+					page, err := c.List(ctx, windowParams)
+					if err == nil && page.LastId != "" && page.LastId == windowParams.LastId {
+						err = fmt.Errorf("task: lastId %q did not advance", page.LastId)
+					}
+					select {
+					case result <- windowPage{tasks: page.Tasks, err: err}:
+					case <-ctx.Done():
+						return
+					}
+					if err != nil || page.LastId == "" {
+						return
+					}
+					windowParams.LastId = page.LastId
+				}
+			}()
+		}
+	}
+
+	// current is the window pages are read from
+	current := 0
+	seen := map[string]struct{}{}
+	next := func(ctx context.Context) ([]onfleet.Task, bool, error) {
+		for {
+			start(ctx, current+options.Concurrency-1)
+			var page windowPage
+			var ok bool
+			select {
+			case page, ok = <-results[current]:
+			case <-ctx.Done():
+				return nil, false, ctx.Err()
+			}
+			if !ok {
+				current++
+				if current == len(windows) {
+					return nil, false, nil
+				}
+				continue
+			}
+			if page.err != nil {
+				return nil, false, fmt.Errorf("task: listing %v to %v: %w", windows[current].from, windows[current].to, page.err)
+			}
+			tasks := make([]onfleet.Task, 0, len(page.tasks))
+			for _, task := range page.tasks {
+				if _, ok := seen[task.ID]; ok {
+					continue
+				}
+				seen[task.ID] = struct{}{}
+				tasks = append(tasks, task)
+			}
+			return tasks, true, nil
+		}
+	}
+	return pagination.NewPaged(ctx, next, options.MaxItems)
+}
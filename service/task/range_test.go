@@ -0,0 +1,240 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/onfleet/gonfleet"
+	"github.com/onfleet/gonfleet/netwrk"
+	"github.com/onfleet/gonfleet/pagination"
+)
+
+// rangeCaller serves Tasks.List pages keyed by "from/lastId". It is safe for
+// concurrent use and records the peak number of calls in flight.
+type rangeCaller struct {
+	mu       sync.Mutex
+	pages    map[string]onfleet.TasksPaginated
+	requests []onfleet.TaskListQueryParams
+	inFlight int
+	peak     int
+}
+
+func (r *rangeCaller) call(ctx context.Context, apiKey string, rlHttpClient *netwrk.RlHttpClient, method string, baseUrl string, pathSegments []string, queryParams any, body any, v any, additionalHeaders ...[2]string) error {
+	params := queryParams.(onfleet.TaskListQueryParams)
+	r.mu.Lock()
+	r.requests = append(r.requests, params)
+	r.inFlight++
+	if r.inFlight > r.peak {
+		r.peak = r.inFlight
+	}
+	page, ok := r.pages[fmt.Sprintf("%d/%s", params.From, params.LastId)]
+	r.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	r.mu.Lock()
+	r.inFlight--
+	r.mu.Unlock()
+	if !ok {
+		return errors.New("unexpected request")
+	}
+	b, _ := json.Marshal(page)
+	return json.Unmarshal(b, v)
+}
+
+func tasksWithIds(ids ...string) []onfleet.Task {
+	tasks := make([]onfleet.Task, len(ids))
+	for i, id := range ids {
+		tasks[i] = onfleet.Task{ID: id}
+	}
+	return tasks
+}
+
+// rangeWindow splits days 0-10 into three windows.
+const rangeWindow = 4 * 24 * time.Hour
+
+var rangeStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func day(n int) time.Time {
+	return rangeStart.AddDate(0, 0, n)
+}
+
+// threeWindowPages serves days 0-10 split into 4 day windows: [0,4] [4,8]
+// [8,10]. The first window has two pages and t3 is matched by two windows.
+func threeWindowPages() map[string]onfleet.TasksPaginated {
+	return map[string]onfleet.TasksPaginated{
+		fmt.Sprintf("%d/", day(0).UnixMilli()):   {Tasks: tasksWithIds("t1", "t2"), LastId: "t2"},
+		fmt.Sprintf("%d/t2", day(0).UnixMilli()): {Tasks: tasksWithIds("t3")},
+		fmt.Sprintf("%d/", day(4).UnixMilli()):   {Tasks: tasksWithIds("t3", "t4")},
+		fmt.Sprintf("%d/", day(8).UnixMilli()):   {Tasks: tasksWithIds("t5")},
+	}
+}
+
+func taskIds(tasks []onfleet.Task) []string {
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	return ids
+}
+
+func TestSplitRange(t *testing.T) {
+	windows := splitRange(day(0), day(10), rangeWindow)
+
+	assert.Equal(t, []timeWindow{
+		{from: day(0), to: day(4)},
+		{from: day(4), to: day(8)},
+		{from: day(8), to: day(10)},
+	}, windows)
+	assert.Equal(t, []timeWindow{{from: day(0), to: day(0)}}, splitRange(day(0), day(0), time.Hour))
+	assert.Equal(t, []timeWindow{{from: day(0), to: day(1)}}, splitRange(day(0), day(1), 24*time.Hour))
+}
+
+func TestClient_ListRange(t *testing.T) {
+	caller := &rangeCaller{pages: threeWindowPages()}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	params := onfleet.TaskListQueryParams{State: "3", LastId: "ignored"}
+	tasks, err := client.ListRange(context.Background(), day(0), day(10), rangeWindow, params, nil).All()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2", "t3", "t4", "t5"}, taskIds(tasks))
+	assert.Len(t, caller.requests, 4)
+	for _, request := range caller.requests {
+		assert.Equal(t, "3", request.State)
+		assert.LessOrEqual(t, request.To-request.From, rangeWindow.Milliseconds())
+	}
+	assert.Equal(t, day(10).UnixMilli(), caller.requests[3].To)
+	assert.Equal(t, 1, caller.peak)
+}
+
+func TestClient_ListRange_Concurrent(t *testing.T) {
+	caller := &rangeCaller{pages: threeWindowPages()}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	tasks, err := client.ListRange(context.Background(), day(0), day(10), rangeWindow, onfleet.TaskListQueryParams{}, &ListRangeOptions{
+		Concurrency: 2,
+	}).All()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2", "t3", "t4", "t5"}, taskIds(tasks))
+	assert.LessOrEqual(t, caller.peak, 2)
+}
+
+func TestClient_ListRange_MaxItems(t *testing.T) {
+	caller := &rangeCaller{pages: threeWindowPages()}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	tasks, err := client.ListRange(context.Background(), day(0), day(10), rangeWindow, onfleet.TaskListQueryParams{}, &ListRangeOptions{
+		MaxItems: 3,
+	}).All()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2", "t3"}, taskIds(tasks))
+	assert.Len(t, caller.requests, 2) // the later windows are never fetched
+}
+
+func TestClient_ListRange_MaxItemsStopsPaging(t *testing.T) {
+	pages := map[string]onfleet.TasksPaginated{}
+	for i, lastId := range []string{"", "t1", "t2", "t3", "t4"} {
+		next := fmt.Sprintf("t%d", i+1)
+		pages[fmt.Sprintf("%d/%s", day(0).UnixMilli(), lastId)] = onfleet.TasksPaginated{Tasks: tasksWithIds(next), LastId: next}
+	}
+	caller := &rangeCaller{pages: pages}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	tasks, err := client.ListRange(context.Background(), day(0), day(1), rangeWindow, onfleet.TaskListQueryParams{}, &ListRangeOptions{
+		MaxItems: 2,
+	}).All()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2"}, taskIds(tasks))
+	caller.mu.Lock()
+	defer caller.mu.Unlock()
+	assert.LessOrEqual(t, len(caller.requests), 3) // at most one page ahead
+}
+
+// blockingCaller serves the first window and blocks every later one until
+// its context is canceled.
+type blockingCaller struct {
+	canceled chan int64
+}
+
+func (b *blockingCaller) call(ctx context.Context, apiKey string, rlHttpClient *netwrk.RlHttpClient, method string, baseUrl string, pathSegments []string, queryParams any, body any, v any, additionalHeaders ...[2]string) error {
+	params := queryParams.(onfleet.TaskListQueryParams)
+	if params.From == day(0).UnixMilli() {
+		b, _ := json.Marshal(onfleet.TasksPaginated{Tasks: tasksWithIds("t1", "t2")})
+		return json.Unmarshal(b, v)
+	}
+	<-ctx.Done()
+	b.canceled <- params.From
+	return ctx.Err()
+}
+
+func TestClient_ListRange_StopCancelsWindows(t *testing.T) {
+	for name, stop := range map[string]func(it *pagination.Paginator[onfleet.Task]){
+		"MaxItems": func(it *pagination.Paginator[onfleet.Task]) {
+			for it.Next() {
+			}
+		},
+		"Close": func(it *pagination.Paginator[onfleet.Task]) {
+			it.Next()
+			it.Close()
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			caller := &blockingCaller{canceled: make(chan int64, 2)}
+			client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+			it := client.ListRange(context.Background(), day(0), day(10), rangeWindow, onfleet.TaskListQueryParams{}, &ListRangeOptions{
+				Concurrency: 3,
+				MaxItems:    1,
+			})
+			stop(it)
+
+			for i := 0; i < 2; i++ {
+				select {
+				case <-caller.canceled:
+				case <-time.After(time.Second):
+					t.Fatal("window still in flight")
+				}
+			}
+			assert.False(t, it.Next())
+		})
+	}
+}
+
+func TestClient_ListRange_WindowError(t *testing.T) {
+	pages := threeWindowPages()
+	delete(pages, fmt.Sprintf("%d/", day(4).UnixMilli()))
+	caller := &rangeCaller{pages: pages}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	tasks, err := client.ListRange(context.Background(), day(0), day(10), rangeWindow, onfleet.TaskListQueryParams{}, &ListRangeOptions{
+		Concurrency: 3,
+	}).All()
+
+	assert.Error(t, err)
+	assert.Equal(t, []string{"t1", "t2", "t3"}, taskIds(tasks))
+}
+
+func TestClient_ListRange_InvalidRange(t *testing.T) {
+	caller := &rangeCaller{pages: threeWindowPages()}
+	client := Plug("test_api_key", nil, "https://api.example.com/tasks", caller.call)
+
+	_, err := client.ListRange(context.Background(), day(10), day(0), rangeWindow, onfleet.TaskListQueryParams{}, nil).All()
+
+	assert.Error(t, err)
+	assert.Empty(t, caller.requests)
+
+	_, err = client.ListRange(context.Background(), day(0), day(10), 0, onfleet.TaskListQueryParams{}, nil).All()
+
+	assert.ErrorContains(t, err, "window 0s is not positive")
+	assert.Empty(t, caller.requests)
+}
@@ -0,0 +1,20 @@
+package task
+
+import "context"
+
+type skipValidationKey struct{}
+
+// WithoutValidation returns a copy of ctx that makes Create, BatchCreate,
+// BatchCreateAsync and Update send their params without validating them
+// first, e.g. to rely on a server side check the client does not know.
+func WithoutValidation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipValidationKey{}, true)
+}
+
+// validate runs params.Validate unless ctx was made by WithoutValidation.
+func validate(ctx context.Context, params interface{ Validate() error }) error {
+	if skip, _ := ctx.Value(skipValidationKey{}).(bool); skip {
+		return nil
+	}
+	return params.Validate()
+}
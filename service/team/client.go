@@ -1,10 +1,12 @@
 package team
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/onfleet/gonfleet"
 	"github.com/onfleet/gonfleet/netwrk"
+	"github.com/onfleet/gonfleet/pagination"
 )
 
 type Client struct {
@@ -24,9 +26,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/get-single-team
-func (c *Client) Get(teamId string) (onfleet.Team, error) {
+func (c *Client) Get(ctx context.Context, teamId string) (onfleet.Team, error) {
 	team := onfleet.Team{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -40,9 +43,10 @@ func (c *Client) Get(teamId string) (onfleet.Team, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/list-teams
-func (c *Client) List() ([]onfleet.Team, error) {
+func (c *Client) List(ctx context.Context) ([]onfleet.Team, error) {
 	teams := []onfleet.Team{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -56,9 +60,10 @@ func (c *Client) List() ([]onfleet.Team, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/create-team
-func (c *Client) Create(params onfleet.TeamCreateParams) (onfleet.Team, error) {
+func (c *Client) Create(ctx context.Context, params onfleet.TeamCreateParams) (onfleet.Team, error) {
 	team := onfleet.Team{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -72,9 +77,10 @@ func (c *Client) Create(params onfleet.TeamCreateParams) (onfleet.Team, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/update-team
-func (c *Client) Update(teamId string, params onfleet.TeamUpdateParams) (onfleet.Team, error) {
+func (c *Client) Update(ctx context.Context, teamId string, params onfleet.TeamUpdateParams) (onfleet.Team, error) {
 	team := onfleet.Team{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -88,8 +94,9 @@ func (c *Client) Update(teamId string, params onfleet.TeamUpdateParams) (onfleet
 }
 
 // Reference https://docs.onfleet.com/reference/delete-team
-func (c *Client) Delete(teamId string) error {
+func (c *Client) Delete(ctx context.Context, teamId string) error {
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodDelete,
@@ -103,9 +110,10 @@ func (c *Client) Delete(teamId string) error {
 }
 
 // Reference https://docs.onfleet.com/reference/team-auto-dispatch
-func (c *Client) AutoDispatch(teamId string, params *onfleet.TeamAutoDispatchParams) (onfleet.TeamAutoDispatch, error) {
+func (c *Client) AutoDispatch(ctx context.Context, teamId string, params *onfleet.TeamAutoDispatchParams) (onfleet.TeamAutoDispatch, error) {
 	autoDispatch := onfleet.TeamAutoDispatch{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -119,9 +127,10 @@ func (c *Client) AutoDispatch(teamId string, params *onfleet.TeamAutoDispatchPar
 }
 
 // Reference https://docs.onfleet.com/reference/delivery-estimate
-func (c *Client) GetWorkerEta(teamId string, params onfleet.TeamWorkerEtaQueryParams) (onfleet.TeamWorkerEta, error) {
+func (c *Client) GetWorkerEta(ctx context.Context, teamId string, params onfleet.TeamWorkerEtaQueryParams) (onfleet.TeamWorkerEta, error) {
 	teamWorkerEta := onfleet.TeamWorkerEta{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -135,9 +144,10 @@ func (c *Client) GetWorkerEta(teamId string, params onfleet.TeamWorkerEtaQueryPa
 }
 
 // Reference https://docs.onfleet.com/reference/list-tasks-in-team
-func (c *Client) ListTasks(teamId string, params *onfleet.TeamTasksListQueryParams) (onfleet.TeamTasks, error) {
+func (c *Client) ListTasks(ctx context.Context, teamId string, params *onfleet.TeamTasksListQueryParams) (onfleet.TeamTasks, error) {
 	teamTasks := onfleet.TeamTasks{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -149,3 +159,20 @@ func (c *Client) ListTasks(teamId string, params *onfleet.TeamTasksListQueryPara
 	)
 	return teamTasks, err
 }
+
+// ListAllTasks returns a paginator over every task of the team, following
+// lastId from page to page. maxItems caps the number of tasks returned; zero
+// means no cap.
+func (c *Client) ListAllTasks(ctx context.Context, teamId string, params *onfleet.TeamTasksListQueryParams, maxItems int) *pagination.Paginator[onfleet.Task] {
+	pageParams := onfleet.TeamTasksListQueryParams{}
+	if params != nil {
+		pageParams = *params
+	}
+	fetch := func(ctx context.Context, lastId string) ([]onfleet.Task, string, error) {
+		query := pageParams
+		query.LastId = lastId
+		page, err := c.ListTasks(ctx, teamId, &query)
+		return page.Tasks, page.LastId, err
+	}
+	return pagination.New(ctx, pageParams.LastId, fetch, maxItems)
+}
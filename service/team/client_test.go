@@ -1,6 +1,7 @@
 package team
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ func TestClient_Get(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/teams", mockClient.MockCaller)
 
-	team, err := client.Get("team_123")
+	team, err := client.Get(context.Background(), "team_123")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTeam.ID, team.ID)
@@ -46,7 +47,7 @@ func TestClient_List(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/teams", mockClient.MockCaller)
 
-	teams, err := client.List()
+	teams, err := client.List(context.Background())
 
 	assert.NoError(t, err)
 	assert.Len(t, teams, 1)
@@ -75,7 +76,7 @@ func TestClient_Create(t *testing.T) {
 		Hub:                  "hub_123",
 	}
 
-	team, err := client.Create(params)
+	team, err := client.Create(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTeam.ID, team.ID)
@@ -101,7 +102,7 @@ func TestClient_Update(t *testing.T) {
 		Name: "Updated Team Name",
 	}
 
-	team, err := client.Update("team_123", params)
+	team, err := client.Update(context.Background(), "team_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTeam.ID, team.ID)
@@ -121,7 +122,7 @@ func TestClient_Delete(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/teams", mockClient.MockCaller)
 
-	err := client.Delete("team_123")
+	err := client.Delete(context.Background(), "team_123")
 
 	assert.NoError(t, err)
 	mockClient.AssertRequestMade("DELETE", "/teams/team_123")
@@ -151,7 +152,7 @@ func TestClient_AutoDispatch(t *testing.T) {
 		TaskTimeWindow:        []int64{32400, 61200}, // 9 AM to 5 PM
 	}
 
-	response, err := client.AutoDispatch("team_123", &params)
+	response, err := client.AutoDispatch(context.Background(), "team_123", &params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedResponse.DispatchId, response.DispatchId)
@@ -191,7 +192,7 @@ func TestClient_GetWorkerEta(t *testing.T) {
 		ServiceTime:     300,
 	}
 
-	response, err := client.GetWorkerEta("team_123", params)
+	response, err := client.GetWorkerEta(context.Background(), "team_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "worker_123", response.WorkerId)
@@ -225,7 +226,7 @@ func TestClient_ListTasks(t *testing.T) {
 		LastId:       "prev_task_456",
 	}
 
-	response, err := client.ListTasks("team_123", &params)
+	response, err := client.ListTasks(context.Background(), "team_123", &params)
 
 	assert.NoError(t, err)
 	assert.Len(t, response.Tasks, 1)
@@ -234,6 +235,39 @@ func TestClient_ListTasks(t *testing.T) {
 	mockClient.AssertRequestMade("GET", "/teams/team_123/tasks")
 }
 
+func TestClient_ListAllTasks(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponseSequence("/teams/team_123/tasks",
+		testingutil.MockResponse{StatusCode: 200, Body: onfleet.TeamTasks{
+			Tasks:  []onfleet.Task{{ID: "t1"}, {ID: "t2"}},
+			LastId: "t2",
+		}},
+		testingutil.MockResponse{StatusCode: 200, Body: onfleet.TeamTasks{
+			Tasks: []onfleet.Task{{ID: "t3"}},
+		}},
+	)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/teams", mockClient.MockCaller)
+
+	params := &onfleet.TeamTasksListQueryParams{IsPickupTask: "false"}
+	tasks, err := client.ListAllTasks(context.Background(), "team_123", params, 0).All()
+
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 3)
+	assert.Equal(t, "t3", tasks[2].ID)
+	assert.Equal(t, "", params.LastId) // caller params are not modified
+
+	var lastIds []string
+	for _, q := range mockClient.QueryHistory {
+		pageParams := q.(*onfleet.TeamTasksListQueryParams)
+		assert.Equal(t, "false", pageParams.IsPickupTask)
+		lastIds = append(lastIds, pageParams.LastId)
+	}
+	assert.Equal(t, []string{"", "t2"}, lastIds)
+}
+
 
 func TestClient_ErrorScenarios(t *testing.T) {
 	tests := []struct {
@@ -249,7 +283,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/teams/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Get("nonexistent")
+				_, err := client.Get(context.Background(), "nonexistent")
 				return err
 			},
 		},
@@ -259,7 +293,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/teams",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.Create(onfleet.TeamCreateParams{})
+				_, err := client.Create(context.Background(), onfleet.TeamCreateParams{})
 				return err
 			},
 		},
@@ -269,7 +303,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/teams/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				_, err := client.Update("nonexistent", onfleet.TeamUpdateParams{})
+				_, err := client.Update(context.Background(), "nonexistent", onfleet.TeamUpdateParams{})
 				return err
 			},
 		},
@@ -279,7 +313,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/teams/team_123",
 			statusCode: 403,
 			operation: func(client *Client) error {
-				return client.Delete("team_123")
+				return client.Delete(context.Background(), "team_123")
 			},
 		},
 		{
@@ -288,7 +322,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/teams/team_123/dispatch",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.AutoDispatch("team_123", &onfleet.TeamAutoDispatchParams{})
+				_, err := client.AutoDispatch(context.Background(), "team_123", &onfleet.TeamAutoDispatchParams{})
 				return err
 			},
 		},
@@ -1,6 +1,7 @@
 package webhook
 
 import (
+	"context"
     "net/http"
 
 	"github.com/onfleet/gonfleet"
@@ -24,9 +25,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/list-webhooks
-func (c *Client) List() ([]onfleet.Webhook, error) {
+func (c *Client) List(ctx context.Context) ([]onfleet.Webhook, error) {
 	webhooks := []onfleet.Webhook{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -40,9 +42,10 @@ func (c *Client) List() ([]onfleet.Webhook, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/create-webhook
-func (c *Client) Create(params onfleet.WebhookCreateParams) (onfleet.Webhook, error) {
+func (c *Client) Create(ctx context.Context, params onfleet.WebhookCreateParams) (onfleet.Webhook, error) {
 	webhook := onfleet.Webhook{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -56,8 +59,9 @@ func (c *Client) Create(params onfleet.WebhookCreateParams) (onfleet.Webhook, er
 }
 
 // Reference https://docs.onfleet.com/reference/delete-webhook
-func (c *Client) Delete(webhookId string) error {
+func (c *Client) Delete(ctx context.Context, webhookId string) error {
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodDelete,
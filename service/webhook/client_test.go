@@ -1,6 +1,7 @@
 package webhook
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,7 +24,7 @@ func TestClient_List(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/webhooks", mockClient.MockCaller)
 
-	webhooks, err := client.List()
+	webhooks, err := client.List(context.Background())
 
 	assert.NoError(t, err)
 	assert.Len(t, webhooks, 1)
@@ -54,7 +55,7 @@ func TestClient_Create(t *testing.T) {
 		Threshold: 5.0,
 	}
 
-	webhook, err := client.Create(params)
+	webhook, err := client.Create(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedWebhook.ID, webhook.ID)
@@ -75,7 +76,7 @@ func TestClient_Delete(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/webhooks", mockClient.MockCaller)
 
-	err := client.Delete("webhook_123")
+	err := client.Delete(context.Background(), "webhook_123")
 
 	assert.NoError(t, err)
 	mockClient.AssertRequestMade("DELETE", "/webhooks/webhook_123")
@@ -180,7 +181,7 @@ func TestClient_WebhookTriggerTypes(t *testing.T) {
 				Url:     "https://api.example.com/webhook/onfleet",
 			}
 
-			webhook, err := client.Create(params)
+			webhook, err := client.Create(context.Background(), params)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.trigger, webhook.Trigger)
@@ -202,7 +203,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/webhooks",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.Create(onfleet.WebhookCreateParams{
+				_, err := client.Create(context.Background(), onfleet.WebhookCreateParams{
 					Name:    "Invalid Webhook",
 					Trigger: 0,
 					Url:     "invalid-url",
@@ -216,7 +217,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/webhooks",
 			statusCode: 400,
 			operation: func(client *Client) error {
-				_, err := client.Create(onfleet.WebhookCreateParams{
+				_, err := client.Create(context.Background(), onfleet.WebhookCreateParams{
 					Name:    "Invalid Webhook",
 					Trigger: 999,
 					Url:     "https://api.example.com/webhook",
@@ -230,7 +231,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/webhooks/nonexistent",
 			statusCode: 404,
 			operation: func(client *Client) error {
-				return client.Delete("nonexistent")
+				return client.Delete(context.Background(), "nonexistent")
 			},
 		},
 		{
@@ -239,7 +240,7 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			url:        "/webhooks",
 			statusCode: 401,
 			operation: func(client *Client) error {
-				_, err := client.List()
+				_, err := client.List(context.Background())
 				return err
 			},
 		},
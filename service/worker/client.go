@@ -1,10 +1,12 @@
 package worker
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/onfleet/gonfleet"
 	"github.com/onfleet/gonfleet/netwrk"
+	"github.com/onfleet/gonfleet/pagination"
 )
 
 type Client struct {
@@ -24,9 +26,10 @@ func Plug(apiKey string, rlHttpClient *netwrk.RlHttpClient, url string, call net
 }
 
 // Reference https://docs.onfleet.com/reference/get-single-worker
-func (c *Client) Get(workerId string) (onfleet.Worker, error) {
+func (c *Client) Get(ctx context.Context, workerId string) (onfleet.Worker, error) {
 	worker := onfleet.Worker{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -40,9 +43,10 @@ func (c *Client) Get(workerId string) (onfleet.Worker, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/get-single-worker
-func (c *Client) GetWithQuery(workerId string, params onfleet.WorkerGetQueryParams) (map[string]any, error) {
+func (c *Client) GetWithQuery(ctx context.Context, workerId string, params onfleet.WorkerGetQueryParams) (map[string]any, error) {
 	worker := map[string]any{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -56,9 +60,10 @@ func (c *Client) GetWithQuery(workerId string, params onfleet.WorkerGetQueryPara
 }
 
 // Reference https://docs.onfleet.com/reference/list-workers
-func (c *Client) List() ([]onfleet.Worker, error) {
+func (c *Client) List(ctx context.Context) ([]onfleet.Worker, error) {
 	workers := []onfleet.Worker{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -72,9 +77,10 @@ func (c *Client) List() ([]onfleet.Worker, error) {
 }
 
 // Reference https://docs.onfleet.com/reference/querying-by-metadata
-func (c *Client) ListWithMetadataQuery(metadata []onfleet.Metadata) ([]onfleet.Worker, error) {
+func (c *Client) ListWithMetadataQuery(ctx context.Context, metadata []onfleet.Metadata) ([]onfleet.Worker, error) {
 	workers := []onfleet.Worker{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -88,9 +94,10 @@ func (c *Client) ListWithMetadataQuery(metadata []onfleet.Metadata) ([]onfleet.W
 }
 
 // Reference // Reference https://docs.onfleet.com/reference/list-workers
-func (c *Client) ListWithQuery(params onfleet.WorkerListQueryParams) ([]map[string]any, error) {
+func (c *Client) ListWithQuery(ctx context.Context, params onfleet.WorkerListQueryParams) ([]map[string]any, error) {
 	workers := []map[string]any{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -104,9 +111,10 @@ func (c *Client) ListWithQuery(params onfleet.WorkerListQueryParams) ([]map[stri
 }
 
 // Reference https://docs.onfleet.com/reference/get-workers-schedule
-func (c *Client) GetSchedule(workerId string) (onfleet.WorkerScheduleEntries, error) {
+func (c *Client) GetSchedule(ctx context.Context, workerId string) (onfleet.WorkerScheduleEntries, error) {
 	scheduleEntries := onfleet.WorkerScheduleEntries{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -120,9 +128,10 @@ func (c *Client) GetSchedule(workerId string) (onfleet.WorkerScheduleEntries, er
 }
 
 // Reference https://docs.onfleet.com/reference/get-workers-by-location
-func (c *Client) ListWorkersByLocation(params onfleet.WorkersByLocationListQueryParams) (onfleet.WorkersByLocation, error) {
+func (c *Client) ListWorkersByLocation(ctx context.Context, params onfleet.WorkersByLocationListQueryParams) (onfleet.WorkersByLocation, error) {
 	workersByLocation := onfleet.WorkersByLocation{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -136,9 +145,10 @@ func (c *Client) ListWorkersByLocation(params onfleet.WorkersByLocationListQuery
 }
 
 // Reference https://docs.onfleet.com/reference/set-workers-schedule
-func (c *Client) SetSchedule(workerId string, entries onfleet.WorkerScheduleEntries) (onfleet.WorkerScheduleEntries, error) {
+func (c *Client) SetSchedule(ctx context.Context, workerId string, entries onfleet.WorkerScheduleEntries) (onfleet.WorkerScheduleEntries, error) {
 	scheduleEntries := onfleet.WorkerScheduleEntries{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -152,9 +162,10 @@ func (c *Client) SetSchedule(workerId string, entries onfleet.WorkerScheduleEntr
 }
 
 // Reference https://docs.onfleet.com/reference/list-workers-assigned-tasks
-func (c *Client) ListTasks(workerId string, params *onfleet.WorkerTasksListQueryParams) (onfleet.WorkerTasks, error) {
+func (c *Client) ListTasks(ctx context.Context, workerId string, params *onfleet.WorkerTasksListQueryParams) (onfleet.WorkerTasks, error) {
 	workerTasks := onfleet.WorkerTasks{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodGet,
@@ -167,10 +178,28 @@ func (c *Client) ListTasks(workerId string, params *onfleet.WorkerTasksListQuery
 	return workerTasks, err
 }
 
+// ListAllTasks returns a paginator over every task assigned to the worker,
+// following lastId from page to page. maxItems caps the number of tasks
+// returned; zero means no cap.
+func (c *Client) ListAllTasks(ctx context.Context, workerId string, params *onfleet.WorkerTasksListQueryParams, maxItems int) *pagination.Paginator[onfleet.Task] {
+	pageParams := onfleet.WorkerTasksListQueryParams{}
+	if params != nil {
+		pageParams = *params
+	}
+	fetch := func(ctx context.Context, lastId string) ([]onfleet.Task, string, error) {
+		query := pageParams
+		query.LastId = lastId
+		page, err := c.ListTasks(ctx, workerId, &query)
+		return page.Tasks, page.LastId, err
+	}
+	return pagination.New(ctx, pageParams.LastId, fetch, maxItems)
+}
+
 // Reference https://docs.onfleet.com/reference/create-worker
-func (c *Client) Create(params onfleet.WorkerCreateParams) (onfleet.Worker, error) {
+func (c *Client) Create(ctx context.Context, params onfleet.WorkerCreateParams) (onfleet.Worker, error) {
 	worker := onfleet.Worker{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPost,
@@ -184,9 +213,10 @@ func (c *Client) Create(params onfleet.WorkerCreateParams) (onfleet.Worker, erro
 }
 
 // Reference https://docs.onfleet.com/reference/update-worker
-func (c *Client) Update(workerId string, params onfleet.WorkerUpdateParams) (onfleet.Worker, error) {
+func (c *Client) Update(ctx context.Context, workerId string, params onfleet.WorkerUpdateParams) (onfleet.Worker, error) {
 	worker := onfleet.Worker{}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -200,8 +230,9 @@ func (c *Client) Update(workerId string, params onfleet.WorkerUpdateParams) (onf
 }
 
 // Reference https://docs.onfleet.com/reference/delete-worker
-func (c *Client) Delete(workerId string) error {
+func (c *Client) Delete(ctx context.Context, workerId string) error {
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodDelete,
@@ -216,14 +247,18 @@ func (c *Client) Delete(workerId string) error {
 
 // Reference https://docs.onfleet.com/reference/metadata
 // MetadataSet atomically adds or updates metadata fields without affecting other metadata
-func (c *Client) MetadataSet(workerId string, metadata ...onfleet.Metadata) (onfleet.Worker, error) {
+func (c *Client) MetadataSet(ctx context.Context, workerId string, metadata ...onfleet.Metadata) (onfleet.Worker, error) {
 	worker := onfleet.Worker{}
+	if err := onfleet.ValidateMetadata(metadata); err != nil {
+		return worker, err
+	}
 	body := map[string]any{
 		"metadata": map[string]any{
 			"$set": metadata,
 		},
 	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
@@ -238,7 +273,7 @@ func (c *Client) MetadataSet(workerId string, metadata ...onfleet.Metadata) (onf
 
 // Reference https://docs.onfleet.com/reference/metadata
 // MetadataPop atomically removes metadata fields without affecting other metadata
-func (c *Client) MetadataPop(workerId string, names ...string) (onfleet.Worker, error) {
+func (c *Client) MetadataPop(ctx context.Context, workerId string, names ...string) (onfleet.Worker, error) {
 	worker := onfleet.Worker{}
 
 	popArray := make([]map[string]string, len(names))
@@ -252,6 +287,7 @@ func (c *Client) MetadataPop(workerId string, names ...string) (onfleet.Worker,
 		},
 	}
 	err := c.call(
+		ctx,
 		c.apiKey,
 		c.rlHttpClient,
 		http.MethodPut,
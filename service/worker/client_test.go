@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ func TestClient_Get(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
 
-	worker, err := client.Get("worker_123")
+	worker, err := client.Get(context.Background(), "worker_123")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedWorker.ID, worker.ID)
@@ -42,7 +43,7 @@ func TestClient_Get_NotFound(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
 
-	worker, err := client.Get("nonexistent")
+	worker, err := client.Get(context.Background(), "nonexistent")
 
 	assert.Error(t, err)
 	assert.Equal(t, "", worker.ID)
@@ -76,7 +77,7 @@ func TestClient_GetWithQuery(t *testing.T) {
 		To:        1672531199,
 	}
 
-	response, err := client.GetWithQuery("worker_123", params)
+	response, err := client.GetWithQuery(context.Background(), "worker_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "worker_123", response["id"])
@@ -100,7 +101,7 @@ func TestClient_List(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
 
-	workers, err := client.List()
+	workers, err := client.List(context.Background())
 
 	assert.NoError(t, err)
 	assert.Len(t, workers, 1)
@@ -132,7 +133,7 @@ func TestClient_ListWithQuery(t *testing.T) {
 		Teams:  "team_123,team_456",
 	}
 
-	response, err := client.ListWithQuery(params)
+	response, err := client.ListWithQuery(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Len(t, response, 1)
@@ -164,7 +165,7 @@ func TestClient_ListWithMetadataQuery(t *testing.T) {
 		},
 	}
 
-	workers, err := client.ListWithMetadataQuery(metadata)
+	workers, err := client.ListWithMetadataQuery(context.Background(), metadata)
 
 	assert.NoError(t, err)
 	assert.Len(t, workers, 1)
@@ -187,7 +188,7 @@ func TestClient_Create(t *testing.T) {
 
 	params := testingutil.GetSampleWorkerCreateParams()
 
-	worker, err := client.Create(params)
+	worker, err := client.Create(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedWorker.ID, worker.ID)
@@ -212,7 +213,7 @@ func TestClient_Create_ValidationError(t *testing.T) {
 		// Missing name, phone, teams
 	}
 
-	worker, err := client.Create(params)
+	worker, err := client.Create(context.Background(), params)
 
 	assert.Error(t, err)
 	assert.Equal(t, "", worker.ID)
@@ -236,7 +237,7 @@ func TestClient_Update(t *testing.T) {
 		Name: "Updated Name",
 	}
 
-	worker, err := client.Update("worker_123", params)
+	worker, err := client.Update(context.Background(), "worker_123", params)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedWorker.ID, worker.ID)
@@ -256,7 +257,7 @@ func TestClient_Delete(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
 
-	err := client.Delete("worker_123")
+	err := client.Delete(context.Background(), "worker_123")
 
 	assert.NoError(t, err)
 	mockClient.AssertRequestMade("DELETE", "/workers/worker_123")
@@ -283,7 +284,7 @@ func TestClient_GetSchedule(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
 
-	schedule, err := client.GetSchedule("worker_123")
+	schedule, err := client.GetSchedule(context.Background(), "worker_123")
 
 	assert.NoError(t, err)
 	assert.Len(t, schedule.Entries, 1)
@@ -323,7 +324,7 @@ func TestClient_SetSchedule(t *testing.T) {
 		},
 	}
 
-	schedule, err := client.SetSchedule("worker_123", inputSchedule)
+	schedule, err := client.SetSchedule(context.Background(), "worker_123", inputSchedule)
 
 	assert.NoError(t, err)
 	assert.Len(t, schedule.Entries, 1)
@@ -355,7 +356,7 @@ func TestClient_ListWorkersByLocation(t *testing.T) {
 		Radius:    5000, // 5km radius
 	}
 
-	response, err := client.ListWorkersByLocation(params)
+	response, err := client.ListWorkersByLocation(context.Background(), params)
 
 	assert.NoError(t, err)
 	assert.Len(t, response.Workers, 1)
@@ -388,7 +389,7 @@ func TestClient_ListTasks(t *testing.T) {
 		IsPickupTask: "false",
 	}
 
-	response, err := client.ListTasks("worker_123", params)
+	response, err := client.ListTasks(context.Background(), "worker_123", params)
 
 	assert.NoError(t, err)
 	assert.Len(t, response.Tasks, 1)
@@ -397,6 +398,39 @@ func TestClient_ListTasks(t *testing.T) {
 	mockClient.AssertRequestMade("GET", "/workers/worker_123/tasks")
 }
 
+func TestClient_ListAllTasks(t *testing.T) {
+	mockClient := testingutil.SetupTest(t)
+	defer testingutil.CleanupTest(t, mockClient)
+
+	mockClient.AddResponseSequence("/workers/worker_123/tasks",
+		testingutil.MockResponse{StatusCode: 200, Body: onfleet.WorkerTasks{
+			Tasks:  []onfleet.Task{{ID: "t1"}, {ID: "t2"}},
+			LastId: "t2",
+		}},
+		testingutil.MockResponse{StatusCode: 200, Body: onfleet.WorkerTasks{
+			Tasks: []onfleet.Task{{ID: "t3"}},
+		}},
+	)
+
+	client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
+
+	params := &onfleet.WorkerTasksListQueryParams{IsPickupTask: "false"}
+	tasks, err := client.ListAllTasks(context.Background(), "worker_123", params, 0).All()
+
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 3)
+	assert.Equal(t, "t3", tasks[2].ID)
+	assert.Equal(t, "", params.LastId) // caller params are not modified
+
+	var lastIds []string
+	for _, q := range mockClient.QueryHistory {
+		pageParams := q.(*onfleet.WorkerTasksListQueryParams)
+		assert.Equal(t, "false", pageParams.IsPickupTask)
+		lastIds = append(lastIds, pageParams.LastId)
+	}
+	assert.Equal(t, []string{"", "t2"}, lastIds)
+}
+
 func TestClient_ListTasks_NilParams(t *testing.T) {
 	mockClient := testingutil.SetupTest(t)
 	defer testingutil.CleanupTest(t, mockClient)
@@ -413,7 +447,7 @@ func TestClient_ListTasks_NilParams(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
 
-	response, err := client.ListTasks("worker_123", nil)
+	response, err := client.ListTasks(context.Background(), "worker_123", nil)
 
 	assert.NoError(t, err)
 	assert.Len(t, response.Tasks, 0)
@@ -446,7 +480,7 @@ func TestClient_Get_DifferentAccountStatuses(t *testing.T) {
 
 			client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
 
-			worker, err := client.Get("worker_123")
+			worker, err := client.Get(context.Background(), "worker_123")
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.status, worker.AccountStatus)
@@ -484,7 +518,7 @@ func TestClient_Create_DifferentVehicleTypes(t *testing.T) {
 			params := testingutil.GetSampleWorkerCreateParams()
 			params.Vehicle.Type = tt.vehicleType
 
-			worker, err := client.Create(params)
+			worker, err := client.Create(context.Background(), params)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.vehicleType, worker.Vehicle.Type)
@@ -537,7 +571,7 @@ func TestClient_ListWithQuery_DifferentFilters(t *testing.T) {
 
 			client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
 
-			response, err := client.ListWithQuery(tt.params)
+			response, err := client.ListWithQuery(context.Background(), tt.params)
 
 			assert.NoError(t, err)
 			assert.Len(t, response, 1)
@@ -574,13 +608,13 @@ func TestClient_ErrorScenarios(t *testing.T) {
 			var err error
 			switch tt.method {
 			case "GET":
-				_, err = client.Get("worker_123")
+				_, err = client.Get(context.Background(), "worker_123")
 			case "POST":
-				_, err = client.Create(testingutil.GetSampleWorkerCreateParams())
+				_, err = client.Create(context.Background(), testingutil.GetSampleWorkerCreateParams())
 			case "PUT":
-				_, err = client.Update("nonexistent", onfleet.WorkerUpdateParams{})
+				_, err = client.Update(context.Background(), "nonexistent", onfleet.WorkerUpdateParams{})
 			case "DELETE":
-				err = client.Delete("worker_123")
+				err = client.Delete(context.Background(), "worker_123")
 			}
 
 			assert.Error(t, err)
@@ -616,7 +650,7 @@ func TestClient_MetadataSet(t *testing.T) {
 		},
 	}
 
-	worker, err := client.MetadataSet("worker_123", metadata...)
+	worker, err := client.MetadataSet(context.Background(), "worker_123", metadata...)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedWorker.ID, worker.ID)
@@ -629,6 +663,15 @@ func TestClient_MetadataSet(t *testing.T) {
 	mockClient.AssertRequestMade("PUT", "/workers/worker_123")
 }
 
+func TestClient_MetadataSet_ClientValidation(t *testing.T) {
+	// a nil caller panics if a request is sent
+	client := Plug("test_api_key", nil, "https://api.example.com/workers", nil)
+
+	_, err := client.MetadataSet(context.Background(), "worker_123", onfleet.Metadata{Name: "count"})
+
+	assert.ErrorIs(t, err, onfleet.ErrValidation)
+}
+
 func TestClient_MetadataSet_Atomicity(t *testing.T) {
 	mockClient := testingutil.SetupTest(t)
 	defer testingutil.CleanupTest(t, mockClient)
@@ -663,7 +706,7 @@ func TestClient_MetadataSet_Atomicity(t *testing.T) {
 		},
 	}
 
-	worker, err := client.MetadataSet("worker_123", metadata...)
+	worker, err := client.MetadataSet(context.Background(), "worker_123", metadata...)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedWorker.ID, worker.ID)
@@ -702,7 +745,7 @@ func TestClient_MetadataPop(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
 
-	worker, err := client.MetadataPop("worker_123", "temp_flag")
+	worker, err := client.MetadataPop(context.Background(), "worker_123", "temp_flag")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedWorker.ID, worker.ID)
@@ -736,7 +779,7 @@ func TestClient_MetadataPop_Atomicity(t *testing.T) {
 
 	client := Plug("test_api_key", nil, "https://api.example.com/workers", mockClient.MockCaller)
 
-	worker, err := client.MetadataPop("worker_123", "old_field")
+	worker, err := client.MetadataPop(context.Background(), "worker_123", "old_field")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedWorker.ID, worker.ID)
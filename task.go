@@ -1,5 +1,11 @@
 package onfleet
 
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
 type Task struct {
 	AdditionalQuantities     TaskAdditionalQuantities `json:"additionalQuantities"`
 	Appearance               TaskAppearance           `json:"appearance"`
@@ -17,7 +23,7 @@ type Task struct {
 	EstimatedCompletionTime  *int64                   `json:"estimatedCompletionTime"`
 	ETA                      *int64                   `json:"eta"`
 	Executor                 string                   `json:"executor"`
-	Feedback                 []any                    `json:"feedback"`
+	Feedback                 []TaskFeedback           `json:"feedback"`
 	ID                       string                   `json:"id"`
 	Identity                 TaskIdentity             `json:"identity"`
 	Merchant                 string                   `json:"merchant"`
@@ -63,20 +69,79 @@ type TaskCompletionEvent struct {
 }
 
 type TaskCompletionDetails struct {
-	Actions                []any                 `json:"actions"`
-	Distance               float64               `json:"distance"`
-	Events                 []TaskCompletionEvent `json:"events"`
-	FailureNotes           string                `json:"failureNotes"`
-	FailureReason          string                `json:"failureReason"`
-	FirstLocation          DestinationLocation   `json:"firstLocation"`
-	LastLocation           DestinationLocation   `json:"lastLocation"`
-	Notes                  string                `json:"notes"`
-	PhotoUploadId          *string               `json:"photoUploadId"`
-	PhotoUploadIds         *[]string             `json:"photoUploadIds"`
-	SignatureUploadId      *string               `json:"signatureUploadId"`
-	Success                bool                  `json:"success"`
-	Time                   *int64                `json:"time"`
-	UnavailableAttachments []any                 `json:"unavailableAttachments"`
+	Actions                []TaskCompletionAction      `json:"actions"`
+	Distance               float64                     `json:"distance"`
+	Events                 []TaskCompletionEvent       `json:"events"`
+	FailureNotes           string                      `json:"failureNotes"`
+	FailureReason          string                      `json:"failureReason"`
+	FirstLocation          DestinationLocation         `json:"firstLocation"`
+	LastLocation           DestinationLocation         `json:"lastLocation"`
+	Notes                  string                      `json:"notes"`
+	PhotoUploadId          *string                     `json:"photoUploadId"`
+	PhotoUploadIds         *[]string                   `json:"photoUploadIds"`
+	SignatureUploadId      *string                     `json:"signatureUploadId"`
+	Success                bool                        `json:"success"`
+	Time                   *int64                      `json:"time"`
+	UnavailableAttachments []TaskUnavailableAttachment `json:"unavailableAttachments"`
+}
+
+// TaskCompletionAction is an action taken by the worker while completing
+// the task.
+type TaskCompletionAction struct {
+	Location DestinationLocation `json:"location,omitempty"`
+	Name     string              `json:"name"`
+	Time     int64               `json:"time"`
+	// Raw is the action as sent by Onfleet, including fields not decoded
+	// above.
+	Raw json.RawMessage `json:"-"`
+}
+
+func (a *TaskCompletionAction) UnmarshalJSON(data []byte) error {
+	type plain TaskCompletionAction
+	var p plain
+	raw, err := unmarshalKeepRaw(data, &p)
+	*a = TaskCompletionAction(p)
+	a.Raw = raw
+	return err
+}
+
+// TaskUnavailableAttachment is a required attachment, such as a photo or
+// signature, the worker could not collect.
+type TaskUnavailableAttachment struct {
+	AttachmentType string `json:"attachmentType"`
+	Reason         string `json:"reason"`
+	// Raw is the attachment as sent by Onfleet, including fields not decoded
+	// above.
+	Raw json.RawMessage `json:"-"`
+}
+
+func (a *TaskUnavailableAttachment) UnmarshalJSON(data []byte) error {
+	type plain TaskUnavailableAttachment
+	var p plain
+	raw, err := unmarshalKeepRaw(data, &p)
+	*a = TaskUnavailableAttachment(p)
+	a.Raw = raw
+	return err
+}
+
+// TaskFeedback is a rating left by the recipient of the task.
+type TaskFeedback struct {
+	Comments  string `json:"comments"`
+	Rating    int    `json:"rating"`
+	Time      int64  `json:"time"`
+	UserAgent string `json:"useragent,omitempty"`
+	// Raw is the feedback as sent by Onfleet, including fields not decoded
+	// above.
+	Raw json.RawMessage `json:"-"`
+}
+
+func (f *TaskFeedback) UnmarshalJSON(data []byte) error {
+	type plain TaskFeedback
+	var p plain
+	raw, err := unmarshalKeepRaw(data, &p)
+	*f = TaskFeedback(p)
+	f.Raw = raw
+	return err
 }
 
 type TaskOverrides struct {
@@ -93,8 +158,33 @@ type TaskAdditionalQuantities struct {
 }
 
 type TaskIdentity struct {
-	Checksum        *any `json:"checksum"`
-	FailedScanCount int  `json:"failedScanCount"`
+	Checksum        *TaskIdentityChecksum `json:"checksum"`
+	FailedScanCount int                   `json:"failedScanCount"`
+}
+
+// TaskIdentityChecksum is the checksum of the barcodes scanned for a task.
+type TaskIdentityChecksum struct {
+	// Value is the checksum when Onfleet sends it as a string.
+	Value string
+	// Raw is the checksum as sent by Onfleet, kept for values that are not
+	// strings.
+	Raw json.RawMessage `json:"-"`
+}
+
+func (c *TaskIdentityChecksum) UnmarshalJSON(data []byte) error {
+	c.Raw = append(json.RawMessage(nil), data...)
+	c.Value = ""
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		return json.Unmarshal(data, &c.Value)
+	}
+	return nil
+}
+
+func (c TaskIdentityChecksum) MarshalJSON() ([]byte, error) {
+	if c.Value == "" && len(c.Raw) > 0 {
+		return c.Raw, nil
+	}
+	return json.Marshal(c.Value)
 }
 
 type TaskAppearance struct {
@@ -136,18 +226,18 @@ type TaskParams struct {
 	Container      *TaskContainer       `json:"container,omitempty"`
 	CustomFields   []CustomFieldParams  `json:"customFields,omitempty"`
 	Dependencies   []string             `json:"dependencies,omitempty"`
-	// Destination can string destination id or destination object onfleet.DestinationCreateParams
-	Destination    any        `json:"destination,omitempty"`
-	Executor       string     `json:"executor,omitempty"`
-	Merchant       string     `json:"merchant,omitempty"`
-	Metadata       []Metadata `json:"metadata,omitempty"`
-	Notes          string     `json:"notes,omitempty"`
-	PickupTask     bool       `json:"pickupTask"`
-	Quantity       float64    `json:"quantity,omitempty"`
-	RecipientName  string     `json:"recipientName,omitempty"`
-	RecipientNotes string     `json:"recipientNotes,omitempty"`
-	// Recipients can be slice of string recipient ids or recipient objects []onfleet.RecipientCreateParams
-	Recipients                    any                              `json:"recipients,omitempty"`
+	// Destination is onfleet.DestinationID or onfleet.NewDestination
+	Destination    *DestinationRef `json:"destination,omitempty"`
+	Executor       string          `json:"executor,omitempty"`
+	Merchant       string          `json:"merchant,omitempty"`
+	Metadata       []Metadata      `json:"metadata,omitempty"`
+	Notes          string          `json:"notes,omitempty"`
+	PickupTask     bool            `json:"pickupTask"`
+	Quantity       float64         `json:"quantity,omitempty"`
+	RecipientName  string          `json:"recipientName,omitempty"`
+	RecipientNotes string          `json:"recipientNotes,omitempty"`
+	// Recipients is onfleet.RecipientIDs or onfleet.NewRecipients
+	Recipients                    RecipientRefs                    `json:"recipients,omitempty"`
 	RecipientSkipSmsNotifications bool                             `json:"recipientSkipSMSNotifications,omitempty"`
 	Requirements                  *TaskCompletionRequirementsParam `json:"requirements,omitempty"`
 	ScanOnlyRequiredBarcodes      bool                             `json:"scanOnlyRequiredBarcodes,omitempty"`
@@ -155,6 +245,114 @@ type TaskParams struct {
 	UseMerchantForProxy           bool                             `json:"useMerchantForProxy,omitempty"`
 }
 
+// TaskUpdateParams holds the fields to change with Tasks.Update. Only the
+// fields that are set are sent; use onfleet.Null to clear a field.
+type TaskUpdateParams struct {
+	Appearance     Optional[*TaskAppearanceParam] `json:"appearance"`
+	Barcodes       Optional[[]TaskBarcode]        `json:"barcodes"`
+	CompleteAfter  Optional[int64]                `json:"completeAfter"`
+	CompleteBefore Optional[int64]                `json:"completeBefore"`
+	Container      Optional[*TaskContainer]       `json:"container"`
+	CustomFields   Optional[[]CustomFieldParams]  `json:"customFields"`
+	Dependencies   Optional[[]string]             `json:"dependencies"`
+	// Destination is onfleet.DestinationID or onfleet.NewDestination
+	Destination    Optional[*DestinationRef] `json:"destination"`
+	Executor       Optional[string]          `json:"executor"`
+	Merchant       Optional[string]          `json:"merchant"`
+	Metadata       Optional[[]Metadata]      `json:"metadata"`
+	Notes          Optional[string]          `json:"notes"`
+	PickupTask     Optional[bool]            `json:"pickupTask"`
+	Quantity       Optional[float64]         `json:"quantity"`
+	RecipientName  Optional[string]          `json:"recipientName"`
+	RecipientNotes Optional[string]          `json:"recipientNotes"`
+	// Recipients is onfleet.RecipientIDs or onfleet.NewRecipients
+	Recipients                    Optional[RecipientRefs]                    `json:"recipients"`
+	RecipientSkipSmsNotifications Optional[bool]                             `json:"recipientSkipSMSNotifications"`
+	Requirements                  Optional[*TaskCompletionRequirementsParam] `json:"requirements"`
+	ScanOnlyRequiredBarcodes      Optional[bool]                             `json:"scanOnlyRequiredBarcodes"`
+	ServiceTime                   Optional[float64]                          `json:"serviceTime"`
+	UseMerchantForProxy           Optional[bool]                             `json:"useMerchantForProxy"`
+}
+
+func (p TaskUpdateParams) MarshalJSON() ([]byte, error) {
+	return marshalSetFields(p)
+}
+
+// UpdateParams returns TaskUpdateParams setting the non zero fields of p,
+// matching what Tasks.Update sent for TaskParams before, except that
+// PickupTask is only sent when true. AutoAssign cannot be updated and is
+// dropped.
+func (p TaskParams) UpdateParams() TaskUpdateParams {
+	var u TaskUpdateParams
+	if p.Appearance != nil {
+		u.Appearance = Set(p.Appearance)
+	}
+	if len(p.Barcodes) > 0 {
+		u.Barcodes = Set(p.Barcodes)
+	}
+	if p.CompleteAfter != 0 {
+		u.CompleteAfter = Set(p.CompleteAfter)
+	}
+	if p.CompleteBefore != 0 {
+		u.CompleteBefore = Set(p.CompleteBefore)
+	}
+	if p.Container != nil {
+		u.Container = Set(p.Container)
+	}
+	if len(p.CustomFields) > 0 {
+		u.CustomFields = Set(p.CustomFields)
+	}
+	if len(p.Dependencies) > 0 {
+		u.Dependencies = Set(p.Dependencies)
+	}
+	if p.Destination != nil {
+		u.Destination = Set(p.Destination)
+	}
+	if p.Executor != "" {
+		u.Executor = Set(p.Executor)
+	}
+	if p.Merchant != "" {
+		u.Merchant = Set(p.Merchant)
+	}
+	if len(p.Metadata) > 0 {
+		u.Metadata = Set(p.Metadata)
+	}
+	if p.Notes != "" {
+		u.Notes = Set(p.Notes)
+	}
+	if p.PickupTask {
+		u.PickupTask = Set(true)
+	}
+	if p.Quantity != 0 {
+		u.Quantity = Set(p.Quantity)
+	}
+	if p.RecipientName != "" {
+		u.RecipientName = Set(p.RecipientName)
+	}
+	if p.RecipientNotes != "" {
+		u.RecipientNotes = Set(p.RecipientNotes)
+	}
+	if len(p.Recipients) > 0 {
+		u.Recipients = Set(p.Recipients)
+	}
+	if p.RecipientSkipSmsNotifications {
+		u.RecipientSkipSmsNotifications = Set(true)
+	}
+	if p.Requirements != nil {
+		u.Requirements = Set(p.Requirements)
+	}
+	if p.ScanOnlyRequiredBarcodes {
+		u.ScanOnlyRequiredBarcodes = Set(true)
+	}
+	if p.ServiceTime != 0 {
+		u.ServiceTime = Set(p.ServiceTime)
+	}
+	if p.UseMerchantForProxy {
+		u.UseMerchantForProxy = Set(true)
+	}
+	return u
+}
+
 type TaskAutoAssignMode string
 
 const (
@@ -212,15 +410,43 @@ type TaskBatchCreateResponse struct {
 type TaskBatchCreateError struct {
 	Error RequestErrorMessage `json:"error"`
 	Task  TaskParams          `json:"task"`
+	// Index is the position of Task in the tasks passed to BatchCreate or
+	// BatchCreateAll, or -1 when the task echoed by Onfleet matches none.
+	Index int `json:"-"`
 }
 
 type TaskBatchCreateResponseAsync struct {
-	JobID  string `json:"jobId"`
-	Status string `json:"status"`
+	JobID  string             `json:"jobId"`
+	Status TaskBatchJobStatus `json:"status"`
+}
+
+// TaskBatchJobStatus is the state of a batch job created with
+// BatchCreateAsync. It is decoded case insensitively.
+type TaskBatchJobStatus string
+
+const (
+	TaskBatchJobStatusPending    TaskBatchJobStatus = "pending"
+	TaskBatchJobStatusProcessing TaskBatchJobStatus = "processing"
+	TaskBatchJobStatusCompleted  TaskBatchJobStatus = "completed"
+	TaskBatchJobStatusErrored    TaskBatchJobStatus = "errored"
+)
+
+// IsTerminal reports whether the job has finished, successfully or not.
+func (s TaskBatchJobStatus) IsTerminal() bool {
+	return s == TaskBatchJobStatusCompleted || s == TaskBatchJobStatusErrored
+}
+
+func (s *TaskBatchJobStatus) UnmarshalJSON(data []byte) error {
+	var status string
+	if err := json.Unmarshal(data, &status); err != nil {
+		return err
+	}
+	*s = TaskBatchJobStatus(strings.ToLower(status))
+	return nil
 }
 
 type TaskBatchStatusResponseAsync struct {
-	Status               string                      `json:"status"`
+	Status               TaskBatchJobStatus          `json:"status"`
 	Submitted            string                      `json:"submitted"`
 	TasksReceived        int                         `json:"tasksReceived"`
 	TasksCreated         int                         `json:"tasksCreated"`
@@ -258,26 +484,41 @@ type TaskCloneParams struct {
 type TaskCloneOverridesParam struct {
 	CompleteAfter  int64 `json:"completeAfter,omitempty"`
 	CompleteBefore int64 `json:"completeBefore,omitempty"`
-	// Destination can string destination id or destination object onfleet.DestinationCreateParams
-	Destination any        `json:"destination,omitempty"`
-	Metadata    []Metadata `json:"metadata,omitempty"`
-	Notes       string     `json:"notes,omitempty"`
-	PickupTask  bool       `json:"pickupTask"`
-	// Recipients can be slice of string recipient ids or recipient objects []onfleet.RecipientCreateParams
-	Recipients  any     `json:"recipients,omitempty"`
-	ServiceTime float64 `json:"serviceTime,omitempty"`
+	// Destination is onfleet.DestinationID or onfleet.NewDestination
+	Destination *DestinationRef `json:"destination,omitempty"`
+	Metadata    []Metadata      `json:"metadata,omitempty"`
+	Notes       string          `json:"notes,omitempty"`
+	PickupTask  bool            `json:"pickupTask"`
+	// Recipients is onfleet.RecipientIDs or onfleet.NewRecipients
+	Recipients  RecipientRefs `json:"recipients,omitempty"`
+	ServiceTime float64       `json:"serviceTime,omitempty"`
 }
 
 type TaskListQueryParams struct {
 	// From is required
-	From int64 `json:"from,omitempty,string"`
-	To   int64 `json:"to,omitempty,string"`
+	From int64 `json:"from,omitempty,string" query:"from,omitempty"`
+	To   int64 `json:"to,omitempty,string" query:"to,omitempty"`
 	// Used for pagination
-	LastId               string `json:"lastId,omitempty"`
-	State                string `json:"state,omitempty"`
-	Worker               string `json:"worker,omitempty"`
-	CompleteBeforeBefore int64  `json:"completeBeforeBefore,omitempty,string"`
-	CompleteAfterAfter   int64  `json:"completeAfterAfter,omitempty,string"`
-	Dependencies         string `json:"dependencies,omitempty"`
-	Containers           string `json:"containers,omitempty"`
+	LastId string `json:"lastId,omitempty" query:"lastId,omitempty"`
+	// State is a comma separated list of numeric task states, e.g. "0,1".
+	// Prefer States.
+	State string `json:"state,omitempty" query:"state,omitempty"`
+	// States filters by task state. It is combined with State.
+	States []TaskState `json:"-" query:"state,omitempty,comma"`
+	// Worker is a comma separated list of worker ids. Prefer WorkerIds.
+	Worker string `json:"worker,omitempty" query:"worker,omitempty"`
+	// WorkerIds filters by assigned worker. It is combined with Worker.
+	WorkerIds            []string `json:"-" query:"worker,omitempty,comma"`
+	CompleteBeforeBefore int64    `json:"completeBeforeBefore,omitempty,string" query:"completeBeforeBefore,omitempty"`
+	CompleteAfterAfter   int64    `json:"completeAfterAfter,omitempty,string" query:"completeAfterAfter,omitempty"`
+	// Dependencies is a comma separated list of task ids. Prefer
+	// DependencyIds.
+	Dependencies string `json:"dependencies,omitempty" query:"dependencies,omitempty"`
+	// DependencyIds filters by dependency. It is combined with Dependencies.
+	DependencyIds []string `json:"-" query:"dependencies,omitempty,comma"`
+	// Containers is a comma separated list of container ids. Prefer
+	// ContainerIds.
+	Containers string `json:"containers,omitempty" query:"containers,omitempty"`
+	// ContainerIds filters by container. It is combined with Containers.
+	ContainerIds []string `json:"-" query:"containers,omitempty,comma"`
 }
@@ -0,0 +1,71 @@
+package onfleet
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTask_UnmarshalJSON_CompletionDetails(t *testing.T) {
+	data := []byte(`{
+		"feedback": [{"comments": "Great", "rating": 5, "time": 1641002400000, "useragent": "Mozilla", "source": "sms"}],
+		"completionDetails": {
+			"actions": [{"name": "photo", "time": 1641002300000, "location": [-122.4, 37.7]}],
+			"unavailableAttachments": [{"attachmentType": "SIGNATURE", "reason": "NOT_PRESENT"}]
+		},
+		"destination": {"warnings": ["partial match", {"type": "GEOCODE", "message": "Low accuracy"}]}
+	}`)
+
+	var task Task
+	err := json.Unmarshal(data, &task)
+
+	assert.NoError(t, err)
+	assert.Len(t, task.Feedback, 1)
+	assert.Equal(t, 5, task.Feedback[0].Rating)
+	assert.Equal(t, "Great", task.Feedback[0].Comments)
+	assert.Equal(t, int64(1641002400000), task.Feedback[0].Time)
+	assert.Contains(t, string(task.Feedback[0].Raw), `"source": "sms"`)
+
+	assert.Equal(t, "photo", task.CompletionDetails.Actions[0].Name)
+	assert.Equal(t, DestinationLocation{-122.4, 37.7}, task.CompletionDetails.Actions[0].Location)
+	assert.Equal(t, "SIGNATURE", task.CompletionDetails.UnavailableAttachments[0].AttachmentType)
+	assert.Equal(t, "NOT_PRESENT", task.CompletionDetails.UnavailableAttachments[0].Reason)
+
+	warnings := task.Destination.Warnings
+	assert.Len(t, warnings, 2)
+	assert.Equal(t, "", warnings[0].Message)
+	assert.Equal(t, json.RawMessage(`"partial match"`), warnings[0].Raw)
+	assert.Equal(t, "GEOCODE", warnings[1].Type)
+	assert.Equal(t, "Low accuracy", warnings[1].Message)
+}
+
+func TestTaskFeedback_UnmarshalJSON_Invalid(t *testing.T) {
+	var feedback TaskFeedback
+	err := json.Unmarshal([]byte(`{"rating": "five"}`), &feedback)
+	assert.Error(t, err)
+}
+
+func TestTaskIdentity_UnmarshalJSON_Checksum(t *testing.T) {
+	var identity TaskIdentity
+	err := json.Unmarshal([]byte(`{"checksum": "a1b2c3", "failedScanCount": 1}`), &identity)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "a1b2c3", identity.Checksum.Value)
+	assert.Equal(t, 1, identity.FailedScanCount)
+
+	err = json.Unmarshal([]byte(`{"checksum": {"sha256": "a1b2c3"}}`), &identity)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "", identity.Checksum.Value)
+	assert.JSONEq(t, `{"sha256": "a1b2c3"}`, string(identity.Checksum.Raw))
+	raw, err := json.Marshal(identity.Checksum)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"sha256": "a1b2c3"}`, string(raw))
+
+	identity = TaskIdentity{}
+	err = json.Unmarshal([]byte(`{"checksum": null}`), &identity)
+
+	assert.NoError(t, err)
+	assert.Nil(t, identity.Checksum)
+}
@@ -56,11 +56,11 @@ type TeamWorkerEtaStep struct {
 }
 
 type TeamWorkerEtaQueryParams struct {
-	DropoffLocation         string            `json:"dropoffLocation,omitempty"`
-	PickupLocation          string            `json:"pickupLocation,omitempty"`
-	PickupTime              int64             `json:"pickupTime,omitempty,string"`
-	RestrictedVehiclesTypes WorkerVehicleType `json:"restrictedVehiclesTypes,omitempty"`
-	ServiceTime             float64           `json:"serviceTime,omitempty,string"`
+	DropoffLocation         string            `json:"dropoffLocation,omitempty" query:"dropoffLocation,omitempty"`
+	PickupLocation          string            `json:"pickupLocation,omitempty" query:"pickupLocation,omitempty"`
+	PickupTime              int64             `json:"pickupTime,omitempty,string" query:"pickupTime,omitempty"`
+	RestrictedVehiclesTypes WorkerVehicleType `json:"restrictedVehiclesTypes,omitempty" query:"restrictedVehiclesTypes,omitempty"`
+	ServiceTime             float64           `json:"serviceTime,omitempty,string" query:"serviceTime,omitempty"`
 }
 
 type TeamTasks struct {
@@ -69,13 +69,13 @@ type TeamTasks struct {
 }
 
 type TeamTasksListQueryParams struct {
-	From int64 `json:"from,omitempty,string"`
+	From int64 `json:"from,omitempty,string" query:"from,omitempty"`
 	// IsPickupTask is a boolean represented as a string.
 	//
 	// E.g. "true" or "false".
 	//
 	// Set to empty string "" if both dropoff and pickup tasks should be returned.
-	IsPickupTask string `json:"isPickupTask,omitempty"`
-	LastId       string `json:"lastId,omitempty"`
-	To           int64  `json:"to,omitempty,string"`
+	IsPickupTask string `json:"isPickupTask,omitempty" query:"isPickupTask,omitempty"`
+	LastId       string `json:"lastId,omitempty" query:"lastId,omitempty"`
+	To           int64  `json:"to,omitempty,string" query:"to,omitempty"`
 }
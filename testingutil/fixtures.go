@@ -153,7 +153,7 @@ func GetSampleTask() onfleet.Task {
 		CustomFields: []onfleet.CustomField{},
 		Metadata: []onfleet.Metadata{},
 		Dependencies: []string{},
-		Feedback: []any{},
+		Feedback: []onfleet.TaskFeedback{},
 		AdditionalQuantities: onfleet.TaskAdditionalQuantities{
 			QuantityA: 0.0,
 			QuantityB: 0.0,
@@ -168,7 +168,7 @@ func GetSampleTask() onfleet.Task {
 		},
 		CompletionDetails: onfleet.TaskCompletionDetails{
 			Success: false,
-			Actions: []any{},
+			Actions: []onfleet.TaskCompletionAction{},
 			Events: []onfleet.TaskCompletionEvent{},
 			Distance: 0.0,
 			FailureNotes: "",
@@ -178,7 +178,7 @@ func GetSampleTask() onfleet.Task {
 			PhotoUploadIds: nil,
 			SignatureUploadId: nil,
 			Time: nil,
-			UnavailableAttachments: []any{},
+			UnavailableAttachments: []onfleet.TaskUnavailableAttachment{},
 			FirstLocation: onfleet.DestinationLocation{},
 			LastLocation: onfleet.DestinationLocation{},
 		},
@@ -288,7 +288,7 @@ func GetSampleDestination() onfleet.Destination {
 		TimeCreated: 1640995200,      // 2022-01-01 00:00:00 UTC
 		TimeLastModified: 1640995500, // 2022-01-01 00:05:00 UTC
 		Metadata: []onfleet.Metadata{},
-		Warnings: []any{},
+		Warnings: []onfleet.DestinationWarning{},
 	}
 }
 
@@ -345,7 +345,7 @@ func GetSampleOrganization() onfleet.Organization {
 // GetSampleTaskParams returns sample parameters for creating a task
 func GetSampleTaskParams() onfleet.TaskParams {
 	return onfleet.TaskParams{
-		Destination: onfleet.DestinationCreateParams{
+		Destination: onfleet.NewDestination(onfleet.DestinationCreateParams{
 			Address: onfleet.DestinationAddress{
 				Number:     "789",
 				Street:     "Test Ave",
@@ -355,14 +355,14 @@ func GetSampleTaskParams() onfleet.TaskParams {
 				Country:    "US",
 			},
 			Notes: "Side entrance",
-		},
-		Recipients: []onfleet.RecipientCreateParams{
-			{
+		}),
+		Recipients: onfleet.NewRecipients(
+			onfleet.RecipientCreateParams{
 				Name:  "Bob Johnson",
 				Phone: "+15551112222",
 				Notes: "Call upon arrival",
 			},
-		},
+		),
 		PickupTask: false,
 		Quantity:   2.0,
 		ServiceTime: 10.0,
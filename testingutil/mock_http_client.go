@@ -1,6 +1,7 @@
 package testingutil
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	onfleet "github.com/onfleet/gonfleet"
 	"github.com/onfleet/gonfleet/netwrk"
 )
 
@@ -22,8 +24,13 @@ type MockResponse struct {
 type MockHTTPClient struct {
 	// Responses is a map of URL patterns to mock responses
 	Responses map[string]MockResponse
+	// Sequences maps URL patterns to responses returned one per request, in
+	// order. The last response is repeated once the others are used up.
+	Sequences map[string][]MockResponse
 	// RequestHistory stores all requests made during testing
 	RequestHistory []*http.Request
+	// QueryHistory stores the query params of every request, in order
+	QueryHistory []any
 	// T is the testing context for assertions
 	T *testing.T
 }
@@ -32,6 +39,7 @@ type MockHTTPClient struct {
 func NewMockHTTPClient(t *testing.T) *MockHTTPClient {
 	return &MockHTTPClient{
 		Responses:      make(map[string]MockResponse),
+		Sequences:      make(map[string][]MockResponse),
 		RequestHistory: make([]*http.Request, 0),
 		T:              t,
 	}
@@ -42,8 +50,15 @@ func (m *MockHTTPClient) AddResponse(urlPattern string, response MockResponse) {
 	m.Responses[urlPattern] = response
 }
 
+// AddResponseSequence adds mock responses returned in order for a given URL
+// pattern, e.g. successive pages of a paginated endpoint
+func (m *MockHTTPClient) AddResponseSequence(urlPattern string, responses ...MockResponse) {
+	m.Sequences[urlPattern] = responses
+}
+
 // MockCaller is a test implementation of netwrk.Caller that uses the mock HTTP client
 func (m *MockHTTPClient) MockCaller(
+	ctx context.Context,
 	apiKey string,
 	rlHttpClient *netwrk.RlHttpClient,
 	method string,
@@ -54,6 +69,10 @@ func (m *MockHTTPClient) MockCaller(
 	v any,
 	additionalHeaders ...[2]string,
 ) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// Build the full URL
 	fullURL := baseUrl
 	if pathSegments != nil {
@@ -84,16 +103,29 @@ func (m *MockHTTPClient) MockCaller(
 
 	// Store request in history
 	m.RequestHistory = append(m.RequestHistory, req)
+	m.QueryHistory = append(m.QueryHistory, queryParams)
 
 	// Find matching response
 	var response MockResponse
 	var found bool
 	
-	// Try exact URL match first
-	if resp, ok := m.Responses[fullURL]; ok {
+	// Try sequences first
+	for pattern, seq := range m.Sequences {
+		if len(seq) > 0 && strings.Contains(fullURL, pattern) {
+			response = seq[0]
+			found = true
+			if len(seq) > 1 {
+				m.Sequences[pattern] = seq[1:]
+			}
+			break
+		}
+	}
+
+	// Then exact URL match
+	if resp, ok := m.Responses[fullURL]; ok && !found {
 		response = resp
 		found = true
-	} else {
+	} else if !found {
 		// Try pattern matching
 		for pattern, resp := range m.Responses {
 			if strings.Contains(fullURL, pattern) {
@@ -109,8 +141,21 @@ func (m *MockHTTPClient) MockCaller(
 	}
 
 	// Simulate HTTP status code errors
+	if response.StatusCode == http.StatusTooManyRequests || response.StatusCode == http.StatusPreconditionFailed {
+		return onfleet.TooManyRequestsError{HTTPStatus: response.StatusCode}
+	}
 	if response.StatusCode >= 400 {
-		return fmt.Errorf("HTTP %d error", response.StatusCode)
+		reqErr := onfleet.RequestError{HTTPStatus: response.StatusCode}
+		if response.Body != nil {
+			bodyBytes, err := json.Marshal(response.Body)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(bodyBytes, &reqErr); err != nil {
+				return fmt.Errorf("HTTP %d error", response.StatusCode)
+			}
+		}
+		return reqErr
 	}
 
 	// Marshal response body and unmarshal into target
@@ -177,5 +222,7 @@ func (m *MockHTTPClient) AssertBasicAuth(expectedAPIKey string) {
 // Reset clears all request history and responses
 func (m *MockHTTPClient) Reset() {
 	m.RequestHistory = make([]*http.Request, 0)
+	m.QueryHistory = nil
 	m.Responses = make(map[string]MockResponse)
+	m.Sequences = make(map[string][]MockResponse)
 }
\ No newline at end of file
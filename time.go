@@ -0,0 +1,169 @@
+package onfleet
+
+import "time"
+
+// Millis returns t as Unix epoch milliseconds, the unit of every Onfleet
+// timestamp, or 0 for the zero Time.
+func Millis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+// FromMillis returns the Time of the Unix epoch milliseconds ms, or the zero
+// Time for 0.
+func FromMillis(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+func fromMillisPtr(ms *int64) time.Time {
+	if ms == nil {
+		return time.Time{}
+	}
+	return FromMillis(*ms)
+}
+
+// Accessors on models. Every epoch millisecond field of a model has one;
+// timestamps that are unset or null return the zero Time. An accessor is
+// named after its field without "Time", e.g. Created for TimeCreated and
+// Start for StartTime, or without the name of its type, e.g. Date for
+// DeliveryManifest.ManifestDate. A field named Time becomes At, and fields
+// with nothing to drop take a "Time" suffix, e.g. CompleteAfterTime and
+// ETATime. Params have setters instead, below. DelayTime, ServiceTime and
+// TravelTime are durations in seconds, not timestamps.
+
+func (a Admin) Created() time.Time      { return FromMillis(a.TimeCreated) }
+func (a Admin) LastModified() time.Time { return FromMillis(a.TimeLastModified) }
+
+func (c Container) Created() time.Time      { return FromMillis(c.TimeCreated) }
+func (c Container) LastModified() time.Time { return FromMillis(c.TimeLastModified) }
+
+func (d Destination) Created() time.Time      { return FromMillis(d.TimeCreated) }
+func (d Destination) LastModified() time.Time { return FromMillis(d.TimeLastModified) }
+
+func (o Organization) Created() time.Time      { return FromMillis(o.TimeCreated) }
+func (o Organization) LastModified() time.Time { return FromMillis(o.TimeLastModified) }
+
+func (r Recipient) Created() time.Time      { return FromMillis(r.TimeCreated) }
+func (r Recipient) LastModified() time.Time { return FromMillis(r.TimeLastModified) }
+
+func (t Team) Created() time.Time      { return FromMillis(t.TimeCreated) }
+func (t Team) LastModified() time.Time { return FromMillis(t.TimeLastModified) }
+
+func (s TeamWorkerEtaStep) Completion() time.Time { return FromMillis(s.CompletionTime) }
+
+func (t Task) Created() time.Time             { return FromMillis(t.TimeCreated) }
+func (t Task) LastModified() time.Time        { return FromMillis(t.TimeLastModified) }
+func (t Task) CompleteAfterTime() time.Time   { return fromMillisPtr(t.CompleteAfter) }
+func (t Task) CompleteBeforeTime() time.Time  { return fromMillisPtr(t.CompleteBefore) }
+func (t Task) ETATime() time.Time             { return fromMillisPtr(t.ETA) }
+func (t Task) EstimatedArrival() time.Time    { return fromMillisPtr(t.EstimatedArrivalTime) }
+func (t Task) EstimatedCompletion() time.Time { return fromMillisPtr(t.EstimatedCompletionTime) }
+
+func (d TaskCompletionDetails) At() time.Time { return fromMillisPtr(d.Time) }
+func (e TaskCompletionEvent) At() time.Time   { return FromMillis(e.Time) }
+func (a TaskCompletionAction) At() time.Time  { return FromMillis(a.Time) }
+func (f TaskFeedback) At() time.Time          { return FromMillis(f.Time) }
+func (b TaskCapturedBarcode) At() time.Time   { return FromMillis(b.Time) }
+
+func (r RoutePlan) Created() time.Time      { return FromMillis(r.TimeCreated) }
+func (r RoutePlan) LastModified() time.Time { return FromMillis(r.TimeLastModified) }
+func (r RoutePlan) Start() time.Time        { return FromMillis(r.StartTime) }
+func (r RoutePlan) End() time.Time          { return fromMillisPtr(r.EndTime) }
+func (r RoutePlan) ActualStart() time.Time  { return fromMillisPtr(r.ActualStartTime) }
+func (r RoutePlan) ActualEnd() time.Time    { return fromMillisPtr(r.ActualEndTime) }
+
+func (m DeliveryManifest) Departure() time.Time { return FromMillis(m.DepartureTime) }
+func (m DeliveryManifest) Date() time.Time      { return FromMillis(m.ManifestDate) }
+
+func (t TurnByTurn) ETATime() time.Time { return FromMillis(t.ETA) }
+
+func (w Worker) Created() time.Time      { return FromMillis(w.TimeCreated) }
+func (w Worker) LastModified() time.Time { return FromMillis(w.TimeLastModified) }
+func (w Worker) LastSeen() time.Time     { return FromMillis(w.TimeLastSeen) }
+
+func (r WorkerAddressesRouting) Created() time.Time      { return FromMillis(r.TimeCreated) }
+func (r WorkerAddressesRouting) LastModified() time.Time { return FromMillis(r.TimeLastModified) }
+
+func (v WorkerVehicle) LastModified() time.Time { return FromMillis(v.TimeLastModified) }
+
+func (e WorkerAnalyticsEvent) At() time.Time { return FromMillis(e.Time) }
+
+// ShiftTimes returns the start and end of each shift of s.
+func (s WorkerSchedule) ShiftTimes() [][2]time.Time {
+	shifts := make([][2]time.Time, 0, len(s.Shifts))
+	for _, shift := range s.Shifts {
+		var times [2]time.Time
+		for i := 0; i < len(shift) && i < 2; i++ {
+			times[i] = FromMillis(shift[i])
+		}
+		shifts = append(shifts, times)
+	}
+	return shifts
+}
+
+// Setters on params. Setting the zero Time clears the field.
+
+// AddShift appends a shift from start to end to s.
+func (s *WorkerSchedule) AddShift(start time.Time, end time.Time) {
+	s.Shifts = append(s.Shifts, []int64{Millis(start), Millis(end)})
+}
+
+func (p *TaskParams) SetCompleteAfter(t time.Time)  { p.CompleteAfter = Millis(t) }
+func (p *TaskParams) SetCompleteBefore(t time.Time) { p.CompleteBefore = Millis(t) }
+
+// SetCompleteAfter sets CompleteAfter to t, or to null for the zero Time.
+func (p *TaskUpdateParams) SetCompleteAfter(t time.Time) { p.CompleteAfter = millisOrNull(t) }
+
+// SetCompleteBefore sets CompleteBefore to t, or to null for the zero Time.
+func (p *TaskUpdateParams) SetCompleteBefore(t time.Time) { p.CompleteBefore = millisOrNull(t) }
+
+func millisOrNull(t time.Time) Optional[int64] {
+	if t.IsZero() {
+		return Null[int64]()
+	}
+	return Set(t.UnixMilli())
+}
+
+func (p *TaskCloneOverridesParam) SetCompleteAfter(t time.Time)  { p.CompleteAfter = Millis(t) }
+func (p *TaskCloneOverridesParam) SetCompleteBefore(t time.Time) { p.CompleteBefore = Millis(t) }
+
+func (p *TaskListQueryParams) SetFrom(t time.Time) { p.From = Millis(t) }
+func (p *TaskListQueryParams) SetTo(t time.Time)   { p.To = Millis(t) }
+func (p *TaskListQueryParams) SetCompleteBeforeBefore(t time.Time) {
+	p.CompleteBeforeBefore = Millis(t)
+}
+func (p *TaskListQueryParams) SetCompleteAfterAfter(t time.Time) { p.CompleteAfterAfter = Millis(t) }
+
+func (p *TeamTasksListQueryParams) SetFrom(t time.Time) { p.From = Millis(t) }
+func (p *TeamTasksListQueryParams) SetTo(t time.Time)   { p.To = Millis(t) }
+
+func (p *TeamWorkerEtaQueryParams) SetPickupTime(t time.Time) { p.PickupTime = Millis(t) }
+
+// SetScheduleTimeWindow sets ScheduleTimeWindow to start and end.
+func (p *TeamAutoDispatchParams) SetScheduleTimeWindow(start time.Time, end time.Time) {
+	p.ScheduleTimeWindow = []int64{Millis(start), Millis(end)}
+}
+
+// SetTaskTimeWindow sets TaskTimeWindow to start and end.
+func (p *TeamAutoDispatchParams) SetTaskTimeWindow(start time.Time, end time.Time) {
+	p.TaskTimeWindow = []int64{Millis(start), Millis(end)}
+}
+
+func (p *WorkerGetQueryParams) SetFrom(t time.Time) { p.From = Millis(t) }
+func (p *WorkerGetQueryParams) SetTo(t time.Time)   { p.To = Millis(t) }
+
+func (p *WorkerTasksListQueryParams) SetFrom(t time.Time) { p.From = Millis(t) }
+func (p *WorkerTasksListQueryParams) SetTo(t time.Time)   { p.To = Millis(t) }
+
+func (p *RoutePlanParams) SetStartTime(t time.Time) { p.StartTime = Millis(t) }
+func (p *RoutePlanParams) SetEndTime(t time.Time)   { p.EndTime = Millis(t) }
+
+func (p *RoutePlanListQueryParams) SetStartTimeFrom(t time.Time)   { p.StartTimeFrom = Millis(t) }
+func (p *RoutePlanListQueryParams) SetStartTimeTo(t time.Time)     { p.StartTimeTo = Millis(t) }
+func (p *RoutePlanListQueryParams) SetCreatedTimeFrom(t time.Time) { p.CreatedTimeFrom = Millis(t) }
+func (p *RoutePlanListQueryParams) SetCreatedTimeTo(t time.Time)   { p.CreatedTimeTo = Millis(t) }
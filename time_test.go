@@ -0,0 +1,62 @@
+package onfleet
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMillis(t *testing.T) {
+	at := time.Date(2022, 1, 1, 2, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, int64(1641002400000), Millis(at))
+	assert.True(t, FromMillis(1641002400000).Equal(at))
+	assert.Equal(t, int64(0), Millis(time.Time{}))
+	assert.True(t, FromMillis(0).IsZero())
+}
+
+func TestTask_TimeAccessors(t *testing.T) {
+	var task Task
+	err := json.Unmarshal([]byte(`{"timeCreated": 1641002400000, "completeBefore": 1641006000000, "eta": null}`), &task)
+
+	assert.NoError(t, err)
+	assert.True(t, task.Created().Equal(time.Date(2022, 1, 1, 2, 0, 0, 0, time.UTC)))
+	assert.True(t, task.CompleteBeforeTime().Equal(time.Date(2022, 1, 1, 3, 0, 0, 0, time.UTC)))
+	assert.True(t, task.CompleteAfterTime().IsZero())
+	assert.True(t, task.ETATime().IsZero())
+}
+
+func TestDeliveryManifest_TimeAccessors(t *testing.T) {
+	var manifest DeliveryManifest
+	err := json.Unmarshal([]byte(`{"departureTime": 1641002400000, "turnByTurn": [{"eta": 1641006000000}]}`), &manifest)
+
+	assert.NoError(t, err)
+	assert.True(t, manifest.Departure().Equal(time.Date(2022, 1, 1, 2, 0, 0, 0, time.UTC)))
+	assert.True(t, manifest.Date().IsZero())
+	assert.True(t, manifest.TurnByTurn[0].ETATime().Equal(time.Date(2022, 1, 1, 3, 0, 0, 0, time.UTC)))
+}
+
+func TestTimeSetters(t *testing.T) {
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	var query TaskListQueryParams
+	query.SetFrom(from)
+	query.SetTo(to)
+	assert.Equal(t, int64(1640995200000), query.From)
+	assert.Equal(t, int64(1640998800000), query.To)
+
+	var update TaskUpdateParams
+	update.SetCompleteAfter(from)
+	update.SetCompleteBefore(time.Time{})
+	raw, err := json.Marshal(update)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"completeAfter":1640995200000,"completeBefore":null}`, string(raw))
+
+	var schedule WorkerSchedule
+	schedule.AddShift(from, to)
+	assert.Equal(t, [][]int64{{1640995200000, 1640998800000}}, schedule.Shifts)
+	assert.Equal(t, [][2]time.Time{{from.Local(), to.Local()}}, schedule.ShiftTimes())
+}
@@ -0,0 +1,376 @@
+package onfleet
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// FieldError is a single problem found by a Validate method.
+type FieldError struct {
+	// Field is the json path of the offending field, e.g.
+	// "recipients[0].phone".
+	Field   string
+	Message string
+}
+
+func (err FieldError) Error() string {
+	return err.Field + ": " + err.Message
+}
+
+// ValidationError lists every problem found by a Validate method. It
+// matches ErrValidation with errors.Is.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (err ValidationError) Error() string {
+	problems := make([]string, len(err.Errors))
+	for i, fieldErr := range err.Errors {
+		problems[i] = fieldErr.Error()
+	}
+	return "onfleet: invalid params: " + strings.Join(problems, "; ")
+}
+
+func (err ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// e164Pattern matches phone numbers in E.164 format, e.g. "+15551112222".
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// validator collects field errors under a path prefix.
+type validator struct {
+	prefix string
+	errs   *[]FieldError
+}
+
+func newValidator() validator {
+	return validator{errs: &[]FieldError{}}
+}
+
+// at returns a validator for the field or index path below v, e.g.
+// v.at("recipients").at("[0]").
+func (v validator) at(path string) validator {
+	if v.prefix != "" && !strings.HasPrefix(path, "[") {
+		path = "." + path
+	}
+	return validator{prefix: v.prefix + path, errs: v.errs}
+}
+
+func (v validator) index(i int) validator {
+	return v.at(fmt.Sprintf("[%d]", i))
+}
+
+func (v validator) addf(field string, format string, args ...any) {
+	path := v.at(field).prefix
+	if field == "" {
+		path = v.prefix
+	}
+	*v.errs = append(*v.errs, FieldError{Field: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v validator) err() error {
+	if len(*v.errs) == 0 {
+		return nil
+	}
+	return ValidationError{Errors: *v.errs}
+}
+
+// Validate checks p for problems Onfleet would reject when creating a task,
+// such as a missing Destination, a CompleteAfter later than CompleteBefore,
+// a Destination or recipient setting both or neither of ID and New, a
+// recipient phone not in E.164 format, an empty barcode or an AutoAssign
+// without a Mode. It returns a ValidationError listing every problem, or
+// nil.
+func (p TaskParams) Validate() error {
+	v := newValidator()
+	p.validateCreate(v)
+	return v.err()
+}
+
+// validateCreate checks p as validate does and that it has the Destination
+// every new task needs. Updates and clone overrides may leave it out.
+func (p TaskParams) validateCreate(v validator) {
+	if p.Destination == nil {
+		v.addf("destination", "is required")
+	}
+	p.validate(v)
+}
+
+func (p TaskParams) validate(v validator) {
+	if p.CompleteAfter < 0 {
+		v.addf("completeAfter", "must not be negative")
+	}
+	if p.CompleteBefore < 0 {
+		v.addf("completeBefore", "must not be negative")
+	}
+	if p.CompleteAfter > 0 && p.CompleteBefore > 0 && p.CompleteAfter > p.CompleteBefore {
+		v.addf("completeAfter", "must not be later than completeBefore")
+	}
+	if p.Quantity < 0 {
+		v.addf("quantity", "must not be negative")
+	}
+	if p.ServiceTime < 0 {
+		v.addf("serviceTime", "must not be negative")
+	}
+	for i, dependency := range p.Dependencies {
+		if dependency == "" {
+			v.at("dependencies").index(i).addf("", "must not be empty")
+		}
+	}
+	for i, barcode := range p.Barcodes {
+		if barcode.Data == "" {
+			v.at("barcodes").index(i).addf("data", "is required")
+		}
+	}
+	if p.AutoAssign != nil {
+		switch p.AutoAssign.Mode {
+		case TaskAutoAssignModeDistance, TaskAutoAssignModeLoad:
+		case "":
+			v.at("autoAssign").addf("mode", "is required")
+		default:
+			v.at("autoAssign").addf("mode", "must be %q or %q, got %q", TaskAutoAssignModeDistance, TaskAutoAssignModeLoad, p.AutoAssign.Mode)
+		}
+	}
+	if p.Requirements != nil && p.Requirements.MinimumAge < 0 {
+		v.at("requirements").addf("minimumAge", "must not be negative")
+	}
+	validateMetadata(v.at("metadata"), p.Metadata)
+	validateTaskDestination(v.at("destination"), p.Destination)
+	validateTaskRecipients(v.at("recipients"), p.Recipients)
+}
+
+func validateTaskDestination(v validator, destination *DestinationRef) {
+	if destination == nil {
+		return
+	}
+	switch {
+	case destination.ID != "" && destination.New != nil:
+		v.addf("", "must set only one of ID and New")
+	case destination.New != nil:
+		destination.New.validate(v)
+	case destination.ID == "":
+		v.addf("", "must set ID or New")
+	}
+}
+
+func (p DestinationCreateParams) validate(v validator) {
+	a := p.Address
+	if a.Unparsed == "" && (a.Number == "" || a.Street == "" || a.City == "" || a.Country == "") {
+		v.addf("address", "requires unparsed or number, street, city and country")
+	}
+	if len(p.Location) > 0 {
+		if len(p.Location) != 2 {
+			v.addf("location", "must be [longitude, latitude]")
+		} else if p.Location[0] < -180 || p.Location[0] > 180 || p.Location[1] < -90 || p.Location[1] > 90 {
+			v.addf("location", "must be [longitude, latitude] within range")
+		}
+	}
+	validateMetadata(v.at("metadata"), p.Metadata)
+}
+
+func validateTaskRecipients(v validator, recipients RecipientRefs) {
+	for i, recipient := range recipients {
+		switch {
+		case recipient.ID != "" && recipient.New != nil:
+			v.index(i).addf("", "must set only one of ID and New")
+		case recipient.New != nil:
+			recipient.New.validate(v.index(i))
+		case recipient.ID == "":
+			v.index(i).addf("", "must set ID or New")
+		}
+	}
+}
+
+func (p RecipientCreateParams) validate(v validator) {
+	if p.Name == "" {
+		v.addf("name", "is required")
+	}
+	if p.Phone == "" {
+		v.addf("phone", "is required")
+	} else if !p.SkipPhoneNumberValidation && !e164Pattern.MatchString(p.Phone) {
+		v.addf("phone", "must be in E.164 format, e.g. +15551112222")
+	}
+	validateMetadata(v.at("metadata"), p.Metadata)
+}
+
+// Validate checks the fields of p that are set to a value as
+// TaskParams.Validate does. Fields set to null are not checked.
+func (p TaskUpdateParams) Validate() error {
+	v := newValidator()
+	var t TaskParams
+	t.Barcodes, _ = p.Barcodes.Value()
+	t.CompleteAfter, _ = p.CompleteAfter.Value()
+	t.CompleteBefore, _ = p.CompleteBefore.Value()
+	t.Dependencies, _ = p.Dependencies.Value()
+	t.Destination, _ = p.Destination.Value()
+	t.Metadata, _ = p.Metadata.Value()
+	t.Quantity, _ = p.Quantity.Value()
+	t.Recipients, _ = p.Recipients.Value()
+	t.Requirements, _ = p.Requirements.Value()
+	t.ServiceTime, _ = p.ServiceTime.Value()
+	t.validate(v)
+	return v.err()
+}
+
+// Validate checks the Overrides of p as TaskParams.Validate does, reporting
+// problems under "overrides".
+func (p TaskCloneParams) Validate() error {
+	v := newValidator()
+	if o := p.Overrides; o != nil {
+		TaskParams{
+			CompleteAfter:  o.CompleteAfter,
+			CompleteBefore: o.CompleteBefore,
+			Destination:    o.Destination,
+			Metadata:       o.Metadata,
+			Recipients:     o.Recipients,
+			ServiceTime:    o.ServiceTime,
+		}.validate(v.at("overrides"))
+	}
+	return v.err()
+}
+
+// Validate checks every task of p as TaskParams.Validate does, reporting
+// problems under "tasks[i]".
+func (p TaskBatchCreateParams) Validate() error {
+	v := newValidator()
+	for i, task := range p.Tasks {
+		task.validateCreate(v.at("tasks").index(i))
+	}
+	return v.err()
+}
+
+// Validate checks m against Onfleet's metadata rules: a Name, a known Type,
+// a Subtype exactly for arrays, a Value matching them and known Visibility
+// options. It returns a ValidationError listing every problem, or nil.
+func (m Metadata) Validate() error {
+	v := newValidator()
+	m.validate(v)
+	return v.err()
+}
+
+// ValidateMetadata checks every entry of metadata as Metadata.Validate does
+// and that names are unique, reporting problems under "metadata[i]".
+func ValidateMetadata(metadata []Metadata) error {
+	v := newValidator()
+	validateMetadata(v.at("metadata"), metadata)
+	return v.err()
+}
+
+func validateMetadata(v validator, metadata []Metadata) {
+	seen := map[string]bool{}
+	for i, m := range metadata {
+		m.validate(v.index(i))
+		if m.Name != "" && seen[m.Name] {
+			v.index(i).addf("name", "%q is not unique", m.Name)
+		}
+		seen[m.Name] = true
+	}
+}
+
+func (m Metadata) validate(v validator) {
+	if m.Name == "" {
+		v.addf("name", "is required")
+	}
+	switch m.Type {
+	case MetadataTypeBoolean, MetadataTypeNumber, MetadataTypeString, MetadataTypeObject:
+		if m.Subtype != "" {
+			v.addf("subtype", "must be empty for type %s", m.Type)
+		}
+		if m.Value != nil && !metadataValueIs(reflect.ValueOf(m.Value), m.Type) {
+			v.addf("value", "must be %s, got %T", withArticle(m.Type), m.Value)
+		}
+	case MetadataTypeArray:
+		switch m.Subtype {
+		case MetadataTypeBoolean, MetadataTypeNumber, MetadataTypeString, MetadataTypeObject:
+			if m.Value != nil && !metadataValueIs(reflect.ValueOf(m.Value), m.Type) {
+				v.addf("value", "must be an array, got %T", m.Value)
+				break
+			}
+			if m.Value != nil {
+				rv := reflect.ValueOf(m.Value)
+				for i := 0; i < rv.Len(); i++ {
+					if !metadataValueIs(rv.Index(i), m.Subtype) {
+						v.at("value").index(i).addf("", "must be %s, got %s", withArticle(m.Subtype), describeValue(rv.Index(i)))
+					}
+				}
+			}
+		case "":
+			v.addf("subtype", "is required for arrays")
+		default:
+			v.addf("subtype", "must be boolean, number, string or object, got %q", m.Subtype)
+		}
+	case "":
+		v.addf("type", "is required")
+	default:
+		v.addf("type", "must be boolean, number, string, object or array, got %q", m.Type)
+	}
+	if m.Value == nil {
+		v.addf("value", "is required")
+	}
+	for i, visibility := range m.Visibility {
+		switch visibility {
+		case MetadataVisibilityOptionApi, MetadataVisibilityOptionDashboard, MetadataVisibilityOptionWorker:
+		default:
+			v.at("visibility").index(i).addf("", "must be api, dashboard or worker, got %q", visibility)
+		}
+	}
+}
+
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+// metadataValueIs reports whether rv holds a value of the metadata type
+// typ, either as built in Go or as decoded from JSON.
+func metadataValueIs(rv reflect.Value, typ string) bool {
+	rv, ok := indirect(rv)
+	if !ok {
+		return false
+	}
+	if rv.Type() == jsonNumberType {
+		f, err := json.Number(rv.String()).Float64()
+		return typ == MetadataTypeNumber && err == nil && !math.IsNaN(f) && !math.IsInf(f, 0)
+	}
+	if rv.Type() == rawMessageType || rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+		// encoded as a base64 string or as is, not as an array
+		return false
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		return typ == MetadataTypeBoolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return typ == MetadataTypeNumber
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		return typ == MetadataTypeNumber && !math.IsNaN(f) && !math.IsInf(f, 0)
+	case reflect.String:
+		return typ == MetadataTypeString
+	case reflect.Struct:
+		return typ == MetadataTypeObject && rv.Type() != timeType
+	case reflect.Map:
+		return typ == MetadataTypeObject && rv.Type().Key().Kind() == reflect.String
+	case reflect.Slice, reflect.Array:
+		return typ == MetadataTypeArray
+	}
+	return false
+}
+
+// withArticle returns the metadata type typ preceded by "a" or "an".
+func withArticle(typ string) string {
+	if typ == MetadataTypeObject || typ == MetadataTypeArray {
+		return "an " + typ
+	}
+	return "a " + typ
+}
+
+func describeValue(rv reflect.Value) string {
+	rv, ok := indirect(rv)
+	if !ok {
+		return "null"
+	}
+	return rv.Type().String()
+}
@@ -0,0 +1,203 @@
+package onfleet
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fieldsOf(err error) []string {
+	var validationErr ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+	fields := []string{}
+	for _, fieldErr := range validationErr.Errors {
+		fields = append(fields, fieldErr.Field)
+	}
+	return fields
+}
+
+func validTaskParams() TaskParams {
+	return TaskParams{
+		Destination: NewDestination(DestinationCreateParams{
+			Address: DestinationAddress{Number: "789", Street: "Test Ave", City: "San Francisco", Country: "US"},
+		}),
+		Recipients:     NewRecipients(RecipientCreateParams{Name: "Bob", Phone: "+15551112222"}),
+		CompleteAfter:  1641002400000,
+		CompleteBefore: 1641006000000,
+		Barcodes:       []TaskBarcode{{Data: "abc"}},
+		AutoAssign:     &TaskAutoAssignParam{Mode: TaskAutoAssignModeDistance},
+	}
+}
+
+func TestTaskParams_Validate(t *testing.T) {
+	assert.NoError(t, validTaskParams().Validate())
+	assert.Equal(t, []string{"destination"}, fieldsOf(TaskParams{}.Validate()))
+	assert.NoError(t, TaskParams{Destination: DestinationID("dest_123"), Recipients: RecipientIDs("rec_123")}.Validate())
+	assert.NoError(t, TaskParams{Destination: NewDestination(DestinationCreateParams{Address: DestinationAddress{Unparsed: "1 Main St"}})}.Validate())
+	assert.NoError(t, TaskParams{Destination: DestinationID("dest_123"), Recipients: NewRecipients(RecipientCreateParams{Name: "Bob", Phone: "555-1234", SkipPhoneNumberValidation: true})}.Validate())
+}
+
+func TestTaskParams_Validate_AllProblems(t *testing.T) {
+	params := validTaskParams()
+	params.CompleteAfter = 1641006000001
+	params.Destination = &DestinationRef{ID: "dest_123", New: &DestinationCreateParams{}}
+	params.Recipients = NewRecipients(
+		RecipientCreateParams{Name: "Bob", Phone: "+15551112222"},
+		RecipientCreateParams{Name: "", Phone: "555-111-2222"},
+	)
+	params.Barcodes = []TaskBarcode{{Data: "abc"}, {BlockCompletion: true}}
+	params.AutoAssign = &TaskAutoAssignParam{}
+	params.Dependencies = []string{""}
+
+	err := params.Validate()
+
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.Equal(t, []string{
+		"completeAfter",
+		"dependencies[0]",
+		"barcodes[1].data",
+		"autoAssign.mode",
+		"destination",
+		"recipients[1].name",
+		"recipients[1].phone",
+	}, fieldsOf(err))
+	assert.Contains(t, err.Error(), "recipients[1].phone: must be in E.164 format")
+	assert.Contains(t, err.Error(), "destination: must set only one of ID and New")
+}
+
+func TestTaskParams_Validate_Destination(t *testing.T) {
+	tests := []struct {
+		name        string
+		destination *DestinationRef
+		fields      []string
+	}{
+		{name: "empty id", destination: DestinationID(""), fields: []string{"destination"}},
+		{name: "incomplete address", destination: NewDestination(DestinationCreateParams{Address: DestinationAddress{Street: "Test Ave"}}), fields: []string{"destination.address"}},
+		{name: "bad location", destination: NewDestination(DestinationCreateParams{
+			Address:  DestinationAddress{Unparsed: "1 Main St"},
+			Location: DestinationLocation{200, 10},
+		}), fields: []string{"destination.location"}},
+		{name: "location without latitude", destination: NewDestination(DestinationCreateParams{
+			Address:  DestinationAddress{Unparsed: "1 Main St"},
+			Location: DestinationLocation{10},
+		}), fields: []string{"destination.location"}},
+		{name: "unset", destination: nil, fields: []string{"destination"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := TaskParams{Destination: tt.destination}.Validate()
+			assert.Equal(t, tt.fields, fieldsOf(err))
+		})
+	}
+}
+
+func TestTaskParams_Validate_DestinationOnlyRequiredOnCreate(t *testing.T) {
+	assert.Equal(t, []string{"tasks[0].destination"}, fieldsOf(TaskBatchCreateParams{Tasks: []TaskParams{{}}}.Validate()))
+	assert.NoError(t, TaskUpdateParams{Notes: Set("notes")}.Validate())
+	assert.NoError(t, TaskCloneParams{Overrides: &TaskCloneOverridesParam{Notes: "notes"}}.Validate())
+}
+
+func TestTaskParams_Validate_Recipients(t *testing.T) {
+	assert.Equal(t, []string{"recipients[1]"}, fieldsOf(TaskParams{Destination: DestinationID("dest_123"), Recipients: RecipientIDs("rec_123", "")}.Validate()))
+	assert.Equal(t, []string{"recipients[0]", "recipients[1].phone"}, fieldsOf(TaskParams{Destination: DestinationID("dest_123"), Recipients: RecipientRefs{
+		{ID: "rec_123", New: &RecipientCreateParams{}},
+		{New: &RecipientCreateParams{Name: "Bob"}},
+	}}.Validate()))
+}
+
+func TestTaskBatchCreateParams_Validate(t *testing.T) {
+	invalid := validTaskParams()
+	invalid.Barcodes = []TaskBarcode{{}}
+
+	err := TaskBatchCreateParams{Tasks: []TaskParams{validTaskParams(), invalid, validTaskParams()}}.Validate()
+
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.Equal(t, []string{"tasks[1].barcodes[0].data"}, fieldsOf(err))
+	assert.NoError(t, TaskBatchCreateParams{Tasks: []TaskParams{validTaskParams()}}.Validate())
+}
+
+func TestTaskCloneParams_Validate(t *testing.T) {
+	assert.NoError(t, TaskCloneParams{}.Validate())
+
+	err := TaskCloneParams{Overrides: &TaskCloneOverridesParam{Destination: &DestinationRef{}}}.Validate()
+
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.Equal(t, []string{"overrides.destination"}, fieldsOf(err))
+}
+
+func TestMetadata_Validate(t *testing.T) {
+	valid := []Metadata{
+		{Name: "a", Type: "boolean", Value: true},
+		{Name: "b", Type: "number", Value: 3},
+		{Name: "c", Type: "number", Value: json.Number("3.5")},
+		{Name: "d", Type: "string", Value: "x", Visibility: []MetadataVisibilityOption{"api", "worker"}},
+		{Name: "e", Type: "object", Value: map[string]any{"k": 1}},
+		{Name: "f", Type: "object", Value: struct{ K int }{K: 1}},
+		{Name: "g", Type: "array", Subtype: "number", Value: []any{1.0, 2}},
+		{Name: "h", Type: "array", Subtype: "object", Value: []map[string]string{{"k": "v"}}},
+	}
+	for _, m := range valid {
+		assert.NoError(t, m.Validate(), m.Name)
+	}
+	assert.NoError(t, ValidateMetadata(valid))
+}
+
+func TestMetadata_Validate_Problems(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata Metadata
+		fields   []string
+		message  string
+	}{
+		{name: "missing", metadata: Metadata{}, fields: []string{"name", "type", "value"}},
+		{name: "unknown type", metadata: Metadata{Name: "a", Type: "date", Value: 1}, fields: []string{"type"}},
+		{name: "value mismatch", metadata: Metadata{Name: "a", Type: "number", Value: "3"}, fields: []string{"value"}, message: "must be a number, got string"},
+		{name: "NaN", metadata: Metadata{Name: "a", Type: "number", Value: math.NaN()}, fields: []string{"value"}},
+		{name: "invalid json number", metadata: Metadata{Name: "a", Type: "number", Value: json.Number("abc")}, fields: []string{"value"}},
+		{name: "NaN json number", metadata: Metadata{Name: "a", Type: "number", Value: json.Number("NaN")}, fields: []string{"value"}},
+		{name: "object article", metadata: Metadata{Name: "a", Type: "object", Value: 1}, fields: []string{"value"}, message: "must be an object, got int"},
+		{name: "bytes", metadata: Metadata{Name: "a", Type: "array", Subtype: "number", Value: []byte("hi")}, fields: []string{"value"}, message: "must be an array, got []uint8"},
+		{name: "raw message", metadata: Metadata{Name: "a", Type: "array", Subtype: "number", Value: json.RawMessage(`[1]`)}, fields: []string{"value"}},
+		{name: "subtype on scalar", metadata: Metadata{Name: "a", Type: "string", Subtype: "string", Value: "x"}, fields: []string{"subtype"}},
+		{name: "array without subtype", metadata: Metadata{Name: "a", Type: "array", Value: []string{}}, fields: []string{"subtype"}},
+		{name: "array not a slice", metadata: Metadata{Name: "a", Type: "array", Subtype: "string", Value: "x"}, fields: []string{"value"}},
+		{name: "array element", metadata: Metadata{Name: "a", Type: "array", Subtype: "string", Value: []any{"x", 1, nil}}, fields: []string{"value[1]", "value[2]"}, message: "value[2]: must be a string, got null"},
+		{name: "visibility", metadata: Metadata{Name: "a", Type: "string", Value: "x", Visibility: []MetadataVisibilityOption{"everyone"}}, fields: []string{"visibility[0]"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.metadata.Validate()
+			assert.ErrorIs(t, err, ErrValidation)
+			assert.Equal(t, tt.fields, fieldsOf(err))
+			if tt.message != "" {
+				assert.ErrorContains(t, err, tt.message)
+			}
+		})
+	}
+}
+
+func TestValidateMetadata_Unique(t *testing.T) {
+	err := ValidateMetadata([]Metadata{
+		{Name: "a", Type: "string", Value: "x"},
+		{Name: "b", Type: "string", Value: "y"},
+		{Name: "a", Type: "number", Value: 1},
+	})
+
+	assert.Equal(t, []string{"metadata[2].name"}, fieldsOf(err))
+	assert.ErrorContains(t, err, `"a" is not unique`)
+}
+
+func TestTaskParams_Validate_Metadata(t *testing.T) {
+	params := validTaskParams()
+	params.Metadata = []Metadata{{Name: "a", Type: "number", Value: "1"}}
+	params.Recipients[0].New.Metadata = []Metadata{{Name: "b", Type: "object", Value: 1}}
+
+	assert.Equal(t, []string{"metadata[0].value", "recipients[0].metadata[0].value"}, fieldsOf(params.Validate()))
+}
@@ -41,6 +41,16 @@ type WorkerAdditionalCapacities struct {
 	CapacityC float64 `json:"capacityC"`
 }
 
+type WorkerState int
+
+const (
+	WorkerStateOffDuty WorkerState = 0
+	// WorkerStateIdle is on duty without an active task.
+	WorkerStateIdle WorkerState = 1
+	// WorkerStateActive is on duty with an active task.
+	WorkerStateActive WorkerState = 2
+)
+
 type WorkerVehicleType string
 
 const (
@@ -121,17 +131,28 @@ type WorkerAnalyticsTaskCounts struct {
 }
 
 type WorkerGetQueryParams struct {
-	Analytics bool   `json:"analytics,omitempty"`
-	Filter    string `json:"filter,omitempty"`
-	From      int64  `json:"from,omitempty,string"`
-	To        int64  `json:"to,omitempty,string"`
+	Analytics bool   `json:"analytics,omitempty" query:"analytics,omitempty"`
+	Filter    string `json:"filter,omitempty" query:"filter,omitempty"`
+	From      int64  `json:"from,omitempty,string" query:"from,omitempty"`
+	To        int64  `json:"to,omitempty,string" query:"to,omitempty"`
 }
 
 type WorkerListQueryParams struct {
-	Filter string `json:"filter,omitempty"`
-	Phones string `json:"phones,omitempty"`
-	States string `json:"states,omitempty"`
-	Teams  string `json:"teams,omitempty"`
+	Filter string `json:"filter,omitempty" query:"filter,omitempty"`
+	// Phones is a comma separated list of phone numbers. Prefer
+	// PhoneNumbers.
+	Phones string `json:"phones,omitempty" query:"phones,omitempty"`
+	// PhoneNumbers filters by phone number. It is combined with Phones.
+	PhoneNumbers []string `json:"-" query:"phones,omitempty,comma"`
+	// States is a comma separated list of numeric worker states, e.g. "1,2".
+	// Prefer WorkerStates.
+	States string `json:"states,omitempty" query:"states,omitempty"`
+	// WorkerStates filters by worker state. It is combined with States.
+	WorkerStates []WorkerState `json:"-" query:"states,omitempty,comma"`
+	// Teams is a comma separated list of team ids. Prefer TeamIds.
+	Teams string `json:"teams,omitempty" query:"teams,omitempty"`
+	// TeamIds filters by team. It is combined with Teams.
+	TeamIds []string `json:"-" query:"teams,omitempty,comma"`
 }
 
 type WorkersByLocation struct {
@@ -139,9 +160,9 @@ type WorkersByLocation struct {
 }
 
 type WorkersByLocationListQueryParams struct {
-	Longitude float64 `json:"longitude,string"`
-	Latitude  float64 `json:"latitude,string"`
-	Radius    float64 `json:"radius,omitempty,string"`
+	Longitude float64 `json:"longitude,string" query:"longitude"`
+	Latitude  float64 `json:"latitude,string" query:"latitude"`
+	Radius    float64 `json:"radius,omitempty,string" query:"radius,omitempty"`
 }
 
 type WorkerTasks struct {
@@ -150,15 +171,15 @@ type WorkerTasks struct {
 }
 
 type WorkerTasksListQueryParams struct {
-	From int64 `json:"from,omitempty,string"`
+	From int64 `json:"from,omitempty,string" query:"from,omitempty"`
 	// IsPickupTask is a boolean represented as a string.
 	//
 	// E.g. "true" or "false".
 	//
 	// Set to empty string "" if both dropoff and pickup tasks should be returned.
-	IsPickupTask string `json:"isPickupTask,omitempty"`
-	LastId       string `json:"lastId,omitempty"`
-	To           int64  `json:"to,omitempty,string"`
+	IsPickupTask string `json:"isPickupTask,omitempty" query:"isPickupTask,omitempty"`
+	LastId       string `json:"lastId,omitempty" query:"lastId,omitempty"`
+	To           int64  `json:"to,omitempty,string" query:"to,omitempty"`
 }
 
 type WorkerCreateParams struct {